@@ -0,0 +1,48 @@
+package dec
+
+// Sum returns the sum of all the values with the maximum precision of them.
+// The sum is accumulated in a single DecimalMut.
+func Sum(first Decimal, rest ...Decimal) Decimal {
+	sum := first.lhs()
+	for _, d := range rest {
+		sum.Add(d)
+	}
+	return sum.Val()
+}
+
+// Avg returns the arithmetic mean of all the values rounded to the resultPrecision using the mode m.
+func Avg(resultPrecision Precision, m RoundingMode, first Decimal, rest ...Decimal) Decimal {
+	count := FromUInt64(uint64(len(rest)+1), Z)
+	return Sum(first, rest...).Var().QuoRound(count, resultPrecision, m).Val()
+}
+
+// Min returns the least of the values with the maximum precision of them.
+func Min(first Decimal, rest ...Decimal) Decimal {
+	minimum, _ := MinMax(first, rest...)
+	return minimum
+}
+
+// Max returns the greatest of the values with the maximum precision of them.
+func Max(first Decimal, rest ...Decimal) Decimal {
+	_, maximum := MinMax(first, rest...)
+	return maximum
+}
+
+// MinMax returns the least and the greatest of the values with the maximum precision of them,
+// the first one is returned among the equal values.
+func MinMax(first Decimal, rest ...Decimal) (minimum, maximum Decimal) {
+	minimum, maximum = first, first
+	p := first.Precision()
+	for _, d := range rest {
+		if d.Cmp(minimum) < 0 {
+			minimum = d
+		}
+		if d.Cmp(maximum) > 0 {
+			maximum = d
+		}
+		if dp := d.Precision(); dp > p {
+			p = dp
+		}
+	}
+	return minimum.Rescale(p), maximum.Rescale(p)
+}
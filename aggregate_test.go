@@ -0,0 +1,62 @@
+package dec
+
+import "testing"
+
+func Test_Sum(t *testing.T) {
+	first := Milli.MustParse("1.5")
+	sum := Sum(first, Deci.MustParse("2.5"), Nano.MustParse("0.000000001"), Z.FromInt64(-4))
+	if got, expected := sum.String(), "0.000000001"; got != expected {
+		t.Fatalf("invalid Sum, expected %s, got %s", expected, got)
+	}
+	if got, expected := sum.Precision(), Nano; got != expected {
+		t.Fatalf("invalid Sum precision, expected %d, got %d", expected, got)
+	}
+	if got, expected := first.String(), "1.5"; got != expected {
+		t.Fatalf("Sum should not change the arguments, expected %s, got %s", expected, got)
+	}
+	if got, expected := Sum(Decimal{}).String(), "0"; got != expected {
+		t.Fatalf("invalid Sum of zero value, expected %s, got %s", expected, got)
+	}
+}
+
+func Test_Avg(t *testing.T) {
+	for _, tc := range []struct {
+		values []Decimal
+		p      Precision
+		m      RoundingMode
+		e      string
+	}{
+		{values: []Decimal{Z.FromInt64(1), Z.FromInt64(2)}, p: Deci, m: HalfEven, e: "1.5"},
+		{values: []Decimal{Z.FromInt64(1), Z.FromInt64(2)}, p: Z, m: HalfEven, e: "2"},
+		{values: []Decimal{Z.FromInt64(1), Z.FromInt64(2), Z.FromInt64(2)}, p: Milli, m: HalfUp, e: "1.667"},
+		{values: []Decimal{Centi.MustParse("1.01"), Milli.MustParse("2.002"), Z.FromInt64(-1)}, p: Centi, m: ToZero, e: "0.67"},
+		{values: []Decimal{Nano.MustParse("7.5")}, p: Z, m: ToNegativeInf, e: "7"},
+	} {
+		if got, expected := Avg(tc.p, tc.m, tc.values[0], tc.values[1:]...).String(), tc.e; got != expected {
+			t.Fatalf("invalid Avg, expected %s, got %s", expected, got)
+		}
+	}
+}
+
+func Test_MinMax(t *testing.T) {
+	values := []Decimal{Centi.MustParse("1.01"), Z.FromInt64(-3), Micro.MustParse("2.000001"), Deci.MustParse("2")}
+	minimum, maximum := MinMax(values[0], values[1:]...)
+	if got, expected := minimum.String(), "-3"; got != expected {
+		t.Fatalf("invalid MinMax minimum, expected %s, got %s", expected, got)
+	}
+	if got, expected := maximum.String(), "2.000001"; got != expected {
+		t.Fatalf("invalid MinMax maximum, expected %s, got %s", expected, got)
+	}
+	if minimum.Precision() != Micro || maximum.Precision() != Micro {
+		t.Fatal("MinMax results should have the maximum precision")
+	}
+	if got, expected := Min(values[0], values[1:]...).String(), "-3"; got != expected {
+		t.Fatalf("invalid Min, expected %s, got %s", expected, got)
+	}
+	if got, expected := Max(values[0], values[1:]...).String(), "2.000001"; got != expected {
+		t.Fatalf("invalid Max, expected %s, got %s", expected, got)
+	}
+	if got, expected := Max(Milli.One()).Precision(), Milli; got != expected {
+		t.Fatalf("invalid Max precision of a single value, expected %d, got %d", expected, got)
+	}
+}
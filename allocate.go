@@ -0,0 +1,90 @@
+package dec
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/pr0n1x/go-liners/werr"
+)
+
+var (
+	ErrNoWeights      = werr.New("no allocation weights")
+	ErrNegativeWeight = werr.New("negative allocation weight")
+	ErrZeroWeightsSum = werr.New("allocation weights sum is zero")
+	ErrInvalidParts   = werr.New("number of parts should be positive")
+)
+
+// Allocate splits the total in proportion to the weights at the precision of the total.
+// The units left after the truncated proportional split are given one by one by the largest remainder method,
+// the equal remainders are resolved in favour of the lower index, so the parts always sum up to the total exactly.
+func Allocate(total Decimal, weights ...Decimal) ([]Decimal, error) {
+	if len(weights) == 0 {
+		return nil, ErrNoWeights
+	}
+	// the weights are coerced to the maximum precision of them, only their ratios are important.
+	p := weights[0].Precision()
+	for _, w := range weights[1:] {
+		if wp := w.Precision(); wp > p {
+			p = wp
+		}
+	}
+	units := make([]*big.Int, len(weights))
+	sum := &big.Int{}
+	for i, w := range weights {
+		if w.Sign() < 0 {
+			return nil, ErrNegativeWeight
+		}
+		units[i] = w.Rescale(p).Units()
+		sum.Add(sum, units[i])
+	}
+	if sum.Sign() == 0 {
+		return nil, ErrZeroWeightsSum
+	}
+
+	amount := total.Units()
+	sign := amount.Sign()
+	amount.Abs(amount)
+	parts := make([]*big.Int, len(weights))
+	remainders := make([]*big.Int, len(weights))
+	left := (&big.Int{}).Set(amount)
+	for i, w := range units {
+		parts[i], remainders[i] = (&big.Int{}).QuoRem(w.Mul(w, amount), sum, &big.Int{})
+		left.Sub(left, parts[i])
+	}
+
+	// left is less than the number of the parts.
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]].Cmp(remainders[order[b]]) > 0
+	})
+	one := big.NewInt(1)
+	for i := int64(0); i < left.Int64(); i++ {
+		parts[order[i]].Add(parts[order[i]], one)
+	}
+
+	res := make([]Decimal, len(parts))
+	for i, part := range parts {
+		if sign < 0 {
+			part.Neg(part)
+		}
+		res[i] = FromUnits(part, total.Precision())
+	}
+	return res, nil
+}
+
+// SplitEven splits the total into n equal parts at the precision of the total,
+// the remaining units are given one by one to the first parts.
+func SplitEven(total Decimal, n int) ([]Decimal, error) {
+	if n < 1 {
+		return nil, ErrInvalidParts
+	}
+	weights := make([]Decimal, n)
+	one := One(Z)
+	for i := range weights {
+		weights[i] = one
+	}
+	return Allocate(total, weights...)
+}
@@ -0,0 +1,97 @@
+package dec
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_Allocate(t *testing.T) {
+	for _, tc := range []struct {
+		total   Decimal
+		weights []Decimal
+		parts   []string
+	}{
+		{
+			total:   Nano.MustParse("1"),
+			weights: []Decimal{Z.FromInt64(1), Z.FromInt64(1), Z.FromInt64(1)},
+			parts:   []string{"0.333333334", "0.333333333", "0.333333333"},
+		},
+		{
+			total:   Centi.MustParse("100"),
+			weights: []Decimal{Centi.MustParse("0.5"), Deci.MustParse("0.3"), Milli.MustParse("0.2")},
+			parts:   []string{"50", "30", "20"},
+		},
+		{
+			total:   Centi.MustParse("0.05"),
+			weights: []Decimal{Z.FromInt64(3), Z.FromInt64(7)},
+			parts:   []string{"0.02", "0.03"},
+		},
+		{
+			total:   Centi.MustParse("10").Neg(),
+			weights: []Decimal{Z.FromInt64(1), Z.FromInt64(2), Z.FromInt64(0), Z.FromInt64(3)},
+			parts:   []string{"-1.67", "-3.33", "0", "-5"},
+		},
+		{
+			total:   Z.FromInt64(5),
+			weights: []Decimal{Z.FromInt64(1), Z.FromInt64(1), Z.FromInt64(1), Z.FromInt64(1)},
+			parts:   []string{"2", "1", "1", "1"},
+		},
+	} {
+		parts, err := Allocate(tc.total, tc.weights...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sum := Zero(tc.total.Precision())
+		for i, part := range parts {
+			if got, expected := part.String(), tc.parts[i]; got != expected {
+				t.Fatalf("invalid part #%d of %s, expected %s, got %s", i, tc.total, expected, got)
+			}
+			if got, expected := part.Precision(), tc.total.Precision(); got != expected {
+				t.Fatalf("invalid part precision, expected %d, got %d", expected, got)
+			}
+			sum = sum.Add(part)
+		}
+		if sum.Cmp(tc.total) != 0 {
+			t.Fatalf("parts sum %s is not equal to the total %s", sum, tc.total)
+		}
+	}
+}
+
+func Test_AllocateErrors(t *testing.T) {
+	if _, err := Allocate(Nano.One()); !errors.Is(err, ErrNoWeights) {
+		t.Fatalf("expected ErrNoWeights, got %v", err)
+	}
+	if _, err := Allocate(Nano.One(), Z.FromInt64(1), Z.FromInt64(-1)); !errors.Is(err, ErrNegativeWeight) {
+		t.Fatalf("expected ErrNegativeWeight, got %v", err)
+	}
+	if _, err := Allocate(Nano.One(), Z.Zero(), Nano.Zero()); !errors.Is(err, ErrZeroWeightsSum) {
+		t.Fatalf("expected ErrZeroWeightsSum, got %v", err)
+	}
+}
+
+func Test_SplitEven(t *testing.T) {
+	for _, tc := range []struct {
+		total Decimal
+		n     int
+		parts []string
+	}{
+		{total: Centi.MustParse("10"), n: 3, parts: []string{"3.34", "3.33", "3.33"}},
+		{total: Centi.MustParse("0.05"), n: 3, parts: []string{"0.02", "0.02", "0.01"}},
+		{total: Centi.MustParse("0.05").Neg(), n: 3, parts: []string{"-0.02", "-0.02", "-0.01"}},
+		{total: Z.FromInt64(2), n: 4, parts: []string{"1", "1", "0", "0"}},
+		{total: Nano.MustParse("1.5"), n: 1, parts: []string{"1.5"}},
+	} {
+		parts, err := SplitEven(tc.total, tc.n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, part := range parts {
+			if got, expected := part.String(), tc.parts[i]; got != expected {
+				t.Fatalf("invalid part #%d of %s, expected %s, got %s", i, tc.total, expected, got)
+			}
+		}
+	}
+	if _, err := SplitEven(Nano.One(), 0); !errors.Is(err, ErrInvalidParts) {
+		t.Fatalf("expected ErrInvalidParts, got %v", err)
+	}
+}
@@ -21,6 +21,10 @@ func (d Decimal) Mul(rhs Decimal) Decimal {
 	return d.lhs().Mul(rhs).Val()
 }
 
+func (d Decimal) MulRound(rhs Decimal, resultPrecision Precision, m RoundingMode) Decimal {
+	return d.lhs().MulRound(rhs, resultPrecision, m).Val()
+}
+
 func (d Decimal) Quo(rhs Decimal) Decimal {
 	return d.lhs().Quo(rhs).Val()
 }
@@ -34,12 +38,20 @@ func (d Decimal) Div(rhs Decimal) Decimal {
 	return d.lhs().Div(rhs).Val()
 }
 
+func (d Decimal) QuoRound(rhs Decimal, resultPrecision Precision, m RoundingMode) Decimal {
+	return d.lhs().QuoRound(rhs, resultPrecision, m).Val()
+}
+
+func (d Decimal) Pow(n int64, resultPrecision Precision, m RoundingMode) Decimal {
+	return d.lhs().Pow(n, resultPrecision, m).Val()
+}
+
 func (d Decimal) Mod(rhs Decimal) Decimal {
 	return d.lhs().Mod(rhs).Val()
 }
 
 func (d Decimal) DivMod(rhs Decimal) (div, mod Decimal) {
-	dm, tm := d.lhs().DivMod(rhs, d.p.exp.Zero().Var())
+	dm, tm := d.lhs().DivMod(rhs, d.Precision().Zero().Var())
 	return dm.Val(), tm.Val()
 }
 
@@ -81,3 +93,15 @@ func (d Decimal) Cmp(rhs Decimal) int {
 func (d Decimal) Round(r Precision, m RoundingMode) Decimal {
 	return d.lhs().Round(r, m).Val()
 }
+
+func (d Decimal) Ceil() Decimal {
+	return d.lhs().Ceil().Val()
+}
+
+func (d Decimal) Floor() Decimal {
+	return d.lhs().Floor().Val()
+}
+
+func (d Decimal) Trunc() Decimal {
+	return d.lhs().Trunc().Val()
+}
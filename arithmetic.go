@@ -6,7 +6,7 @@ import (
 
 func (d *DecimalMut) coercePrecision(rhs *Decimal) *DecimalMut {
 	if d == nil {
-		panic("operation on nil *DecimalMut pointer")
+		panic(ErrNilDecimalMut)
 	}
 	if rhs.p == nil {
 		rhs.p = &DecimalMut{}
@@ -47,7 +47,30 @@ func (d *DecimalMut) Sub(rhs Decimal) *DecimalMut {
 func (d *DecimalMut) Mul(rhs Decimal) *DecimalMut {
 	d.coercePrecision(&rhs)
 	d.val.Mul(&d.val, &rhs.p.val)
-	d.val.Div(&d.val, d.exp.multiplierOnlyForReadIPromise())
+	// Quo truncates toward zero, so the extra digits are dropped the same way for both signs.
+	d.val.Quo(&d.val, d.exp.multiplierOnlyForReadIPromise())
+	return d
+}
+
+// MulRound sets d to the product d*rhs computed exactly and rounded to the resultPrecision using the mode m.
+func (d *DecimalMut) MulRound(rhs Decimal, resultPrecision Precision, m RoundingMode) *DecimalMut {
+	if d == nil {
+		panic(ErrNilDecimalMut)
+	}
+	if !m.valid() {
+		panic("invalid rounding mode")
+	}
+	if rhs.p == nil {
+		rhs.p = &DecimalMut{}
+	}
+	// the exact product has d.exp + rhs.exp decimal places.
+	d.val.Mul(&d.val, &rhs.p.val)
+	if shift := int64(resultPrecision) - int64(d.exp) - int64(rhs.p.exp); shift >= 0 {
+		d.val.Mul(&d.val, pow10(shift))
+	} else {
+		quoRound(&d.val, &d.val, pow10(-shift), m)
+	}
+	d.exp = resultPrecision
 	return d
 }
 
@@ -83,6 +106,36 @@ func (d *DecimalMut) Div(rhs Decimal) *DecimalMut {
 	return d
 }
 
+// QuoRound sets d to the quotient d/rhs computed exactly and rounded to the resultPrecision using the mode m.
+// Unlike Quo and Div the result precision does not depend on the precisions of the operands.
+func (d *DecimalMut) QuoRound(rhs Decimal, resultPrecision Precision, m RoundingMode) *DecimalMut {
+	if d == nil {
+		panic(ErrNilDecimalMut)
+	}
+	if !m.valid() {
+		panic("invalid rounding mode")
+	}
+	if rhs.p == nil {
+		rhs.p = &DecimalMut{}
+	}
+	d.quoRound(rhs.p, resultPrecision, m)
+	return d
+}
+
+func (d *DecimalMut) quoRound(rhs *DecimalMut, resultPrecision Precision, m RoundingMode) (exact bool) {
+	// d/rhs = (d.val/10^d.exp) / (rhs.val/10^rhs.exp), so the result units are
+	// d.val * 10^(resultPrecision + rhs.exp - d.exp) / rhs.val.
+	denominator := &rhs.val
+	if shift := int64(resultPrecision) + int64(rhs.exp) - int64(d.exp); shift >= 0 {
+		d.val.Mul(&d.val, pow10(shift))
+	} else {
+		denominator = (&big.Int{}).Mul(denominator, pow10(-shift))
+	}
+	exact = quoRound(&d.val, &d.val, denominator, m)
+	d.exp = resultPrecision
+	return exact
+}
+
 // QuoTail returns a division result and a tail (residual/remainder related to a rescaleTo).
 // For the operation `res, tail := x.DivTail(y)`
 // there is a valid equation `res * y = x - tail`.
@@ -144,3 +197,38 @@ func (d *DecimalMut) Neg() *DecimalMut {
 	d.val.Neg(&d.val)
 	return d
 }
+
+// Pow sets d to d^n rounded to the resultPrecision using the mode m.
+// The power is computed exactly on the units by squaring, so the only rounding is the final one,
+// for a negative n the reciprocal of the exact power is rounded.
+func (d *DecimalMut) Pow(n int64, resultPrecision Precision, m RoundingMode) *DecimalMut {
+	if d == nil {
+		panic(ErrNilDecimalMut)
+	}
+	if !m.valid() {
+		panic("invalid rounding mode")
+	}
+	if n == 0 {
+		d.val.Set(resultPrecision.multiplierOnlyForReadIPromise())
+		d.exp = resultPrecision
+		return d
+	}
+	exponent := big.Int{} // on stack
+	exponent.SetInt64(n).Abs(&exponent)
+	// (val/10^exp)^|n| == val^|n| / 10^(exp*|n|).
+	scale := int64(d.exp) * exponent.Int64()
+	d.val.Exp(&d.val, &exponent, nil)
+	if n > 0 {
+		if shift := int64(resultPrecision) - scale; shift >= 0 {
+			d.val.Mul(&d.val, pow10(shift))
+		} else {
+			quoRound(&d.val, &d.val, pow10(-shift), m)
+		}
+	} else {
+		// 1/(val^|n| / 10^scale) == 10^scale / val^|n|.
+		denominator := (&big.Int{}).Set(&d.val)
+		quoRound(&d.val, pow10(scale+int64(resultPrecision)), denominator, m)
+	}
+	d.exp = resultPrecision
+	return d
+}
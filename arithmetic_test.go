@@ -187,3 +187,120 @@ func Test_MaxFraction(t *testing.T) {
 		t.Fatalf("invalid MaxFraction, expected %s, got %s", expected, res)
 	}
 }
+
+func Test_QuoRound(t *testing.T) {
+	for _, tc := range []struct {
+		frac testFrac
+		p    Precision
+		m    RoundingMode
+		e    string
+	}{
+		{frac: testFrac{n: Z.FromUInt64(1), d: Z.FromUInt64(3)}, p: Atto, m: HalfEven, e: "0.333333333333333333"},
+		{frac: testFrac{n: Z.FromUInt64(2), d: Z.FromUInt64(3)}, p: Atto, m: HalfEven, e: "0.666666666666666667"},
+		{frac: testFrac{n: Nano.FromUInt64(2), d: Milli.FromUInt64(3)}, p: Milli, m: ToZero, e: "0.666"},
+		{frac: testFrac{n: Nano.FromInt64(-2), d: Milli.FromUInt64(3)}, p: Milli, m: HalfUp, e: "-0.667"},
+		{frac: testFrac{n: Nano.FromInt64(-2), d: Milli.FromUInt64(3)}, p: Milli, m: ToPositiveInf, e: "-0.666"},
+		{frac: testFrac{n: Nano.FromInt64(2), d: Milli.FromInt64(-3)}, p: Milli, m: ToNegativeInf, e: "-0.667"},
+		{frac: testFrac{n: Nano.FromUInt64(10), d: Nano.FromUInt64(4)}, p: Z, m: HalfEven, e: "2"},
+		{frac: testFrac{n: Nano.FromUInt64(10), d: Nano.FromUInt64(4)}, p: Z, m: HalfUp, e: "3"},
+		{frac: testFrac{n: Nano.FromUInt64(10), d: Nano.FromUInt64(4)}, p: Deci, m: ToZero, e: "2.5"},
+		{frac: testFrac{n: Centi.MustParse("12345.67"), d: Quecto.MustParse("0.001")}, p: Z, m: HalfEven, e: "12345670"},
+		{frac: testFrac{n: Quecto.MustParse("1"), d: Quecto.MustParse("7")}, p: Centi, m: AwayFromZero, e: "0.15"},
+	} {
+		res := tc.frac.n.QuoRound(tc.frac.d, tc.p, tc.m)
+		if got, expected := res.String(), tc.e; got != expected {
+			t.Fatalf("invalid QuoRound of %s / %s, expected %s, got %s", tc.frac.n, tc.frac.d, expected, got)
+		}
+		if got, expected := res.Precision(), tc.p; got != expected {
+			t.Fatalf("invalid QuoRound result precision, expected %d, got %d", expected, got)
+		}
+	}
+}
+
+func Test_Mul_TruncatesTowardZero(t *testing.T) {
+	for _, tc := range []struct {
+		a, b Decimal
+		e    string
+	}{
+		{a: Milli.MustParse("0.015"), b: Milli.MustParse("0.1"), e: "0.001"},
+		{a: Milli.MustParse("0.015").Neg(), b: Milli.MustParse("0.1"), e: "-0.001"},
+		{a: Milli.MustParse("0.015"), b: Milli.MustParse("0.1").Neg(), e: "-0.001"},
+		{a: Milli.MustParse("0.015").Neg(), b: Milli.MustParse("0.1").Neg(), e: "0.001"},
+		{a: Nano.MustParse("-2.5"), b: Z.FromInt64(3), e: "-7.5"},
+	} {
+		if got, expected := tc.a.Mul(tc.b).String(), tc.e; got != expected {
+			t.Fatalf("invalid Mul of %s * %s, expected %s, got %s", tc.a, tc.b, expected, got)
+		}
+	}
+}
+
+func Test_MulRound(t *testing.T) {
+	// 0.015 * 0.1 == 0.0015 and 0.025 * 0.1 == 0.0025 rounded to Milli.
+	a, b, c := Milli.MustParse("0.015"), Milli.MustParse("0.1"), Milli.MustParse("0.025")
+	for _, tc := range []struct {
+		m        RoundingMode
+		pos, neg string // 0.0015 and -0.0015.
+		tie      string // 0.0025.
+	}{
+		{m: HalfEven, pos: "0.002", neg: "-0.002", tie: "0.002"},
+		{m: HalfUp, pos: "0.002", neg: "-0.001", tie: "0.003"},
+		{m: HalfDown, pos: "0.001", neg: "-0.002", tie: "0.002"},
+		{m: ToZero, pos: "0.001", neg: "-0.001", tie: "0.002"},
+		{m: AwayFromZero, pos: "0.002", neg: "-0.002", tie: "0.003"},
+		{m: ToPositiveInf, pos: "0.002", neg: "-0.001", tie: "0.003"},
+		{m: ToNegativeInf, pos: "0.001", neg: "-0.002", tie: "0.002"},
+		{m: HalfOdd, pos: "0.001", neg: "-0.001", tie: "0.003"},
+		{m: HalfTowardZero, pos: "0.001", neg: "-0.001", tie: "0.002"},
+		{m: ZeroFiveUp, pos: "0.001", neg: "-0.001", tie: "0.002"},
+	} {
+		if got, expected := a.MulRound(b, Milli, tc.m).String(), tc.pos; got != expected {
+			t.Fatalf("invalid MulRound with mode %d, expected %s, got %s", tc.m, expected, got)
+		}
+		if got, expected := a.Neg().MulRound(b, Milli, tc.m).String(), tc.neg; got != expected {
+			t.Fatalf("invalid MulRound with mode %d, expected %s, got %s", tc.m, expected, got)
+		}
+		if got, expected := a.MulRound(b.Neg(), Milli, tc.m).String(), tc.neg; got != expected {
+			t.Fatalf("invalid MulRound with mode %d, expected %s, got %s", tc.m, expected, got)
+		}
+		if got, expected := c.MulRound(b, Milli, tc.m).String(), tc.tie; got != expected {
+			t.Fatalf("invalid MulRound with mode %d, expected %s, got %s", tc.m, expected, got)
+		}
+	}
+	if res := Centi.MustParse("1.5").MulRound(Deci.MustParse("2.5"), Nano, ToZero); res.Precision() != Nano || res.String() != "3.75" {
+		t.Fatalf("invalid MulRound to a higher precision: %s", res)
+	}
+}
+
+func Test_Pow(t *testing.T) {
+	for _, tc := range []struct {
+		n Decimal
+		e int64
+		p Precision
+		m RoundingMode
+		r string
+	}{
+		{n: Centi.MustParse("1.05"), e: 10, p: Nano, m: HalfEven, r: "1.628894627"},
+		{n: Centi.MustParse("1.05"), e: 10, p: Quecto, m: HalfEven, r: "1.62889462677744140625"},
+		{n: Centi.MustParse("1.05"), e: -10, p: Nano, m: HalfEven, r: "0.613913254"},
+		{n: Z.FromUInt64(2), e: -1, p: Centi, m: ToZero, r: "0.5"},
+		{n: Z.FromUInt64(3), e: -1, p: Milli, m: HalfUp, r: "0.333"},
+		{n: Z.FromUInt64(3), e: -2, p: Milli, m: AwayFromZero, r: "0.112"},
+		{n: Deci.MustParse("1.5").Neg(), e: 3, p: Milli, m: ToZero, r: "-3.375"},
+		{n: Deci.MustParse("1.5").Neg(), e: 3, p: Centi, m: ToNegativeInf, r: "-3.38"},
+		{n: Z.FromInt64(-2), e: -3, p: Milli, m: HalfEven, r: "-0.125"},
+		{n: Deci.MustParse("1.1"), e: 2, p: Z, m: HalfEven, r: "1"},
+		{n: Nano.MustParse("123.456"), e: 0, p: Milli, m: HalfEven, r: "1"},
+		{n: Nano.Zero(), e: 5, p: Milli, m: HalfEven, r: "0"},
+	} {
+		res := tc.n.Pow(tc.e, tc.p, tc.m)
+		if got, expected := res.String(), tc.r; got != expected {
+			t.Fatalf("invalid Pow of %s^%d, expected %s, got %s", tc.n, tc.e, expected, got)
+		}
+		if got, expected := res.Precision(), tc.p; got != expected {
+			t.Fatalf("invalid Pow result precision, expected %d, got %d", expected, got)
+		}
+	}
+	if _, err := Nano.Zero().TryPow(-1, Nano, HalfEven); err == nil {
+		t.Fatal("negative power of zero should fail")
+	}
+}
@@ -0,0 +1,188 @@
+package dec
+
+import (
+	"math"
+	"math/big"
+	"runtime"
+	"sync/atomic"
+)
+
+// inflated is a value of AtomicDecimal.units which means the value is kept in AtomicDecimal.big.
+const inflated = math.MinInt64
+
+var int64Pow10 = [...]int64{
+	1, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9,
+	1e10, 1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18,
+}
+
+// AtomicDecimal is a Decimal which could be updated from many goroutines concurrently without locks.
+// The value is kept as int64 units of the precision set by NewAtomicDecimal,
+// it falls back to an immutable Decimal replaced by CompareAndSwap when a value overflows int64
+// or has a higher precision, the precision is coerced to the maximum one as in the other operations.
+// The zero value is a zero of Z precision. AtomicDecimal must not be copied after the first use.
+type AtomicDecimal struct {
+	exp   Precision
+	units atomic.Int64
+	big   atomic.Pointer[DecimalMut]
+}
+
+func NewAtomicDecimal(p Precision) *AtomicDecimal {
+	return &AtomicDecimal{exp: p}
+}
+
+// Load returns the current value.
+func (a *AtomicDecimal) Load() Decimal {
+	if v := a.units.Load(); v != inflated {
+		return FromUnitsInt64(v, a.exp)
+	}
+	return a.loadBig().Copy().Val()
+}
+
+// Store sets the value to d.
+func (a *AtomicDecimal) Store(d Decimal) {
+	a.Swap(d)
+}
+
+// Swap sets the value to d and returns the previous one.
+func (a *AtomicDecimal) Swap(d Decimal) (old Decimal) {
+	if units, ok := a.fastUnits(d); ok {
+		for v := a.units.Load(); v != inflated; v = a.units.Load() {
+			if a.units.CompareAndSwap(v, units) {
+				return FromUnitsInt64(v, a.exp)
+			}
+		}
+	} else if v, ok := a.inflate(d); ok {
+		return FromUnitsInt64(v, a.exp)
+	}
+	for {
+		current := a.loadBig()
+		if a.big.CompareAndSwap(current, a.coerce(d)) {
+			return current.Val()
+		}
+	}
+}
+
+// CompareAndSwap sets the value to new if the current value is equal to old, the precisions aren't compared.
+func (a *AtomicDecimal) CompareAndSwap(old, new Decimal) (swapped bool) {
+	for v := a.units.Load(); v != inflated; v = a.units.Load() {
+		oldUnits, ok := a.fastUnits(old)
+		if !ok || oldUnits != v {
+			return false
+		}
+		if newUnits, ok := a.fastUnits(new); ok {
+			if a.units.CompareAndSwap(v, newUnits) {
+				return true
+			}
+		} else if a.inflateFrom(v, new) {
+			return true
+		}
+	}
+	for {
+		current := a.loadBig()
+		if current.Val().Cmp(old) != 0 {
+			return false
+		}
+		if a.big.CompareAndSwap(current, a.coerce(new)) {
+			return true
+		}
+	}
+}
+
+// Add adds d to the value.
+func (a *AtomicDecimal) Add(d Decimal) {
+	if delta, ok := a.fastUnits(d); ok {
+		for v := a.units.Load(); v != inflated; v = a.units.Load() {
+			sum := v + delta
+			// the signed overflow or the reserved value.
+			if (sum > v) != (delta > 0) || sum == inflated {
+				break
+			}
+			if a.units.CompareAndSwap(v, sum) {
+				return
+			}
+		}
+	}
+	for {
+		if v := a.units.Load(); v != inflated {
+			// move the current value to the big.Int storage and add to it there.
+			a.inflateFrom(v, FromUnitsInt64(v, a.exp))
+			continue
+		}
+		current := a.loadBig()
+		if a.big.CompareAndSwap(current, current.Copy().Add(d)) {
+			return
+		}
+	}
+}
+
+// Sub subtracts d from the value.
+func (a *AtomicDecimal) Sub(d Decimal) {
+	a.Add(d.Neg())
+}
+
+// fastUnits returns int64 units of d at the precision of a if d could be represented exactly.
+func (a *AtomicDecimal) fastUnits(d Decimal) (int64, bool) {
+	if d.p == nil {
+		return 0, true
+	}
+	// the common case without allocations.
+	if shift := a.exp - d.p.exp; d.p.exp <= a.exp && int(shift) < len(int64Pow10) && d.p.val.IsInt64() {
+		v, m := d.p.val.Int64(), int64Pow10[shift]
+		if units := v * m; units/m == v && units != inflated {
+			return units, true
+		}
+	}
+	units := &big.Int{}
+	if d.p.exp > a.exp {
+		rem := &big.Int{}
+		units.QuoRem(&d.p.val, (d.p.exp - a.exp).multiplierOnlyForReadIPromise(), rem)
+		if rem.Sign() != 0 {
+			return 0, false
+		}
+	} else {
+		units.Mul(&d.p.val, (a.exp - d.p.exp).multiplierOnlyForReadIPromise())
+	}
+	if !units.IsInt64() || units.Int64() == inflated {
+		return 0, false
+	}
+	return units.Int64(), true
+}
+
+// inflate moves the value to the big.Int storage replacing it by d, it returns the previous int64 units
+// or false if the value has already been inflated.
+func (a *AtomicDecimal) inflate(d Decimal) (int64, bool) {
+	for v := a.units.Load(); v != inflated; v = a.units.Load() {
+		if a.inflateFrom(v, d) {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// inflateFrom replaces the int64 units v by d kept in the big.Int storage.
+func (a *AtomicDecimal) inflateFrom(v int64, d Decimal) bool {
+	if !a.units.CompareAndSwap(v, inflated) {
+		return false
+	}
+	a.big.Store(a.coerce(d))
+	return true
+}
+
+// coerce returns a copy of d for the big.Int storage with at least the precision of a.
+func (a *AtomicDecimal) coerce(d Decimal) *DecimalMut {
+	v := d.lhs()
+	if v.exp < a.exp {
+		v.Rescale(a.exp)
+	}
+	return v
+}
+
+// loadBig waits until the inflating goroutine stores the value.
+func (a *AtomicDecimal) loadBig() *DecimalMut {
+	for {
+		if p := a.big.Load(); p != nil {
+			return p
+		}
+		runtime.Gosched()
+	}
+}
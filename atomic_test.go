@@ -0,0 +1,112 @@
+package dec
+
+import (
+	"math"
+	"math/big"
+	"sync"
+	"testing"
+)
+
+func Test_AtomicDecimalConcurrentAdd(t *testing.T) {
+	const goroutines, iterations = 8, 1000
+	a := NewAtomicDecimal(Nano)
+	wg := sync.WaitGroup{}
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				a.Add(Milli.MustParse("0.001"))
+				a.Sub(Nano.MustParse("0.000000001"))
+			}
+		}()
+	}
+	wg.Wait()
+	if got, expected := a.Load().String(), "7.999992"; got != expected {
+		t.Fatalf("invalid concurrent sum, expected %s, got %s", expected, got)
+	}
+}
+
+func Test_AtomicDecimalOverflow(t *testing.T) {
+	a := NewAtomicDecimal(Z)
+	a.Store(Z.FromInt64(math.MaxInt64 - 10))
+	const goroutines = 8
+	wg := sync.WaitGroup{}
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 10; i++ {
+				a.Add(Z.FromInt64(1))
+			}
+		}()
+	}
+	wg.Wait()
+	if got, expected := a.Load().String(), "9223372036854775877"; got != expected {
+		t.Fatalf("invalid sum after int64 overflow, expected %s, got %s", expected, got)
+	}
+	if a.Sub(Z.FromInt64(100)); a.Load().String() != "9223372036854775777" {
+		t.Fatalf("invalid Sub in the big.Int storage, got %s", a.Load())
+	}
+}
+
+func Test_AtomicDecimalPrecisionCoercion(t *testing.T) {
+	var a AtomicDecimal
+	a.Add(Z.FromInt64(2))
+	if a.Add(Micro.MustParse("0.000001")); a.Load().String() != "2.000001" {
+		t.Fatalf("invalid sum, got %s", a.Load())
+	}
+	if got, expected := a.Load().Precision(), Micro; got != expected {
+		t.Fatalf("invalid precision, expected %d, got %d", expected, got)
+	}
+}
+
+func Test_AtomicDecimalInflatedPrecision(t *testing.T) {
+	huge := Z.FromUnits(new(big.Int).Lsh(big.NewInt(1), 70))
+	a := NewAtomicDecimal(Nano)
+	a.Store(huge)
+	if got := a.Load(); got.Precision() != Nano || got.Cmp(huge) != 0 {
+		t.Fatalf("invalid value after overflow %s with precision %d", got, got.Precision())
+	}
+	a.Store(Z.FromInt64(1))
+	if got := a.Load(); got.Precision() != Nano || got.String() != "1" {
+		t.Fatalf("invalid value %s with precision %d", got, got.Precision())
+	}
+	if old := a.Swap(Z.FromInt64(2)); old.Precision() != Nano {
+		t.Fatalf("invalid swapped precision %d", old.Precision())
+	}
+	if !a.CompareAndSwap(Z.FromInt64(2), Z.FromInt64(3)) || a.Load().Precision() != Nano {
+		t.Fatalf("invalid value after CompareAndSwap %s with precision %d", a.Load(), a.Load().Precision())
+	}
+	b := NewAtomicDecimal(Nano)
+	if !b.CompareAndSwap(Nano.Zero(), huge) || b.Load().Precision() != Nano {
+		t.Fatalf("invalid precision after inflating CompareAndSwap %d", b.Load().Precision())
+	}
+}
+
+func Test_AtomicDecimalSwapCAS(t *testing.T) {
+	a := NewAtomicDecimal(Centi)
+	if old := a.Swap(Deci.MustParse("1.5")); old.Sign() != 0 {
+		t.Fatalf("invalid Swap old value, got %s", old)
+	}
+	if a.CompareAndSwap(Centi.MustParse("1.51"), Z.FromInt64(3)) {
+		t.Fatal("CompareAndSwap should fail for a different value")
+	}
+	if !a.CompareAndSwap(Milli.MustParse("1.5"), Z.FromInt64(3)) {
+		t.Fatal("CompareAndSwap should succeed for an equal value of other precision")
+	}
+	// the new value does not fit into the fast storage.
+	huge := Z.FromUnits(Max128BitsValue)
+	if !a.CompareAndSwap(Z.FromInt64(3), huge) {
+		t.Fatal("CompareAndSwap should succeed")
+	}
+	if got := a.Load(); got.Cmp(huge) != 0 {
+		t.Fatalf("invalid value after CompareAndSwap, got %s", got)
+	}
+	if old := a.Swap(Centi.One()); old.Cmp(huge) != 0 {
+		t.Fatalf("invalid Swap old value, got %s", old)
+	}
+	if !a.CompareAndSwap(Z.FromInt64(1), Z.FromInt64(2)) || a.Load().String() != "2" {
+		t.Fatal("CompareAndSwap should succeed in the big.Int storage")
+	}
+}
@@ -2,6 +2,7 @@ package benchmarks
 
 import (
 	"math/big"
+	"sync"
 	"testing"
 
 	dec "github.com/pr0n1x/decimal-go"
@@ -108,3 +109,30 @@ func Benchmark_BigFraction_Mul_BigRat(b *testing.B) {
 		results = append(results, new(big.Rat).Mul(rat, three))
 	}
 }
+
+func Benchmark_Concurrent_Add_AtomicDecimal(b *testing.B) {
+	total := dec.NewAtomicDecimal(testPrecision)
+	fee := testPrecision.MustParse("0.015")
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			total.Add(fee)
+		}
+	})
+}
+
+func Benchmark_Concurrent_Add_MutexDecimalMut(b *testing.B) {
+	total := testPrecision.Zero().Var()
+	fee := testPrecision.MustParse("0.015")
+	mu := sync.Mutex{}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			mu.Lock()
+			total.Add(fee)
+			mu.Unlock()
+		}
+	})
+}
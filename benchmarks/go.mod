@@ -2,11 +2,11 @@ module benchmarks
 
 go 1.23.2
 
-require github.com/pr0n1x/decimal-go v0.0.0
+require (
+	github.com/pr0n1x/decimal-go v0.0.0
+	github.com/pr0n1x/go-liners v0.6.0
+)
 
 replace github.com/pr0n1x/decimal-go v0.0.0 => ../
 
-require (
-	github.com/davecgh/go-spew v1.1.1 // indirect
-	github.com/pr0n1x/go-liners v0.6.0 // indirect
-)
+require github.com/davecgh/go-spew v1.1.1 // indirect
@@ -0,0 +1,213 @@
+package dec
+
+import "github.com/pr0n1x/go-liners/werr"
+
+var (
+	ErrDivisionByZero = werr.New("decimal division by zero")
+	ErrNilDecimalMut  = werr.New("operation on nil *DecimalMut pointer")
+)
+
+// Is allows to check a trapped DivisionByZero or InvalidOperation with errors.Is(err, ErrDivisionByZero).
+func (e *SignalError) Is(target error) bool {
+	return target == ErrDivisionByZero && e.Signal&(DivisionByZero|InvalidOperation) != 0
+}
+
+func (d *DecimalMut) checkNil() error {
+	if d == nil {
+		return ErrNilDecimalMut
+	}
+	return nil
+}
+
+func (d *DecimalMut) checkDivisor(rhs Decimal) error {
+	if d == nil {
+		return ErrNilDecimalMut
+	}
+	if rhs.Sign() == 0 {
+		return ErrDivisionByZero
+	}
+	return nil
+}
+
+// TryAdd is the same as Add but returns ErrNilDecimalMut instead of panicking.
+func (d *DecimalMut) TryAdd(rhs Decimal) (*DecimalMut, error) {
+	if err := d.checkNil(); err != nil {
+		return nil, err
+	}
+	return d.Add(rhs), nil
+}
+
+// TrySub is the same as Sub but returns ErrNilDecimalMut instead of panicking.
+func (d *DecimalMut) TrySub(rhs Decimal) (*DecimalMut, error) {
+	if err := d.checkNil(); err != nil {
+		return nil, err
+	}
+	return d.Sub(rhs), nil
+}
+
+// TryMul is the same as Mul but returns ErrNilDecimalMut instead of panicking.
+func (d *DecimalMut) TryMul(rhs Decimal) (*DecimalMut, error) {
+	if err := d.checkNil(); err != nil {
+		return nil, err
+	}
+	return d.Mul(rhs), nil
+}
+
+// TryQuo is the same as Quo but returns ErrDivisionByZero or ErrNilDecimalMut instead of panicking.
+// d stays unchanged on error.
+func (d *DecimalMut) TryQuo(rhs Decimal) (*DecimalMut, error) {
+	if err := d.checkDivisor(rhs); err != nil {
+		return nil, err
+	}
+	return d.Quo(rhs), nil
+}
+
+// TryQuoRem is the same as QuoRem but returns ErrDivisionByZero or ErrNilDecimalMut instead of panicking.
+func (d *DecimalMut) TryQuoRem(rhs Decimal, rem *DecimalMut) (*DecimalMut, *DecimalMut, error) {
+	if err := d.checkDivisor(rhs); err != nil {
+		return nil, nil, err
+	}
+	quo, rem := d.QuoRem(rhs, rem)
+	return quo, rem, nil
+}
+
+// TryDiv is the same as Div but returns ErrDivisionByZero or ErrNilDecimalMut instead of panicking.
+func (d *DecimalMut) TryDiv(rhs Decimal) (*DecimalMut, error) {
+	if err := d.checkDivisor(rhs); err != nil {
+		return nil, err
+	}
+	return d.Div(rhs), nil
+}
+
+// TryQuoRound is the same as QuoRound but returns ErrDivisionByZero or ErrNilDecimalMut instead of panicking.
+func (d *DecimalMut) TryQuoRound(rhs Decimal, resultPrecision Precision, m RoundingMode) (*DecimalMut, error) {
+	if err := d.checkDivisor(rhs); err != nil {
+		return nil, err
+	}
+	return d.QuoRound(rhs, resultPrecision, m), nil
+}
+
+// TryQuoTail is the same as QuoTail but returns ErrDivisionByZero or ErrNilDecimalMut instead of panicking.
+func (d *DecimalMut) TryQuoTail(rhs Decimal, tail *DecimalMut) (*DecimalMut, *DecimalMut, error) {
+	if err := d.checkDivisor(rhs); err != nil {
+		return nil, nil, err
+	}
+	quo, tail := d.QuoTail(rhs, tail)
+	return quo, tail, nil
+}
+
+// TryDivTail is the same as DivTail but returns ErrDivisionByZero or ErrNilDecimalMut instead of panicking.
+func (d *DecimalMut) TryDivTail(rhs Decimal, tail *DecimalMut) (*DecimalMut, *DecimalMut, error) {
+	if err := d.checkDivisor(rhs); err != nil {
+		return nil, nil, err
+	}
+	div, tail := d.DivTail(rhs, tail)
+	return div, tail, nil
+}
+
+// TryMod is the same as Mod but returns ErrDivisionByZero or ErrNilDecimalMut instead of panicking.
+func (d *DecimalMut) TryMod(rhs Decimal) (*DecimalMut, error) {
+	if err := d.checkDivisor(rhs); err != nil {
+		return nil, err
+	}
+	return d.Mod(rhs), nil
+}
+
+// TryDivMod is the same as DivMod but returns an error instead of panicking, m may be nil.
+func (d *DecimalMut) TryDivMod(rhs Decimal, m *DecimalMut) (*DecimalMut, *DecimalMut, error) {
+	if err := d.checkDivisor(rhs); err != nil {
+		return nil, nil, err
+	}
+	if m == nil {
+		m = &DecimalMut{}
+	}
+	div, m := d.DivMod(rhs, m)
+	return div, m, nil
+}
+
+// TryPow is the same as Pow but returns ErrDivisionByZero for a negative power of zero.
+func (d *DecimalMut) TryPow(n int64, resultPrecision Precision, m RoundingMode) (*DecimalMut, error) {
+	if err := d.checkNil(); err != nil {
+		return nil, err
+	}
+	if n < 0 && d.val.Sign() == 0 {
+		return nil, ErrDivisionByZero
+	}
+	return d.Pow(n, resultPrecision, m), nil
+}
+
+// TryQuo is the same as Quo but returns ErrDivisionByZero instead of panicking.
+func (d Decimal) TryQuo(rhs Decimal) (Decimal, error) {
+	if rhs.Sign() == 0 {
+		return Decimal{}, ErrDivisionByZero
+	}
+	return d.Quo(rhs), nil
+}
+
+// TryQuoRem is the same as QuoRem but returns ErrDivisionByZero instead of panicking.
+func (d Decimal) TryQuoRem(rhs Decimal) (quo, rem Decimal, err error) {
+	if rhs.Sign() == 0 {
+		return Decimal{}, Decimal{}, ErrDivisionByZero
+	}
+	quo, rem = d.QuoRem(rhs)
+	return quo, rem, nil
+}
+
+// TryDiv is the same as Div but returns ErrDivisionByZero instead of panicking.
+func (d Decimal) TryDiv(rhs Decimal) (Decimal, error) {
+	if rhs.Sign() == 0 {
+		return Decimal{}, ErrDivisionByZero
+	}
+	return d.Div(rhs), nil
+}
+
+// TryQuoRound is the same as QuoRound but returns ErrDivisionByZero instead of panicking.
+func (d Decimal) TryQuoRound(rhs Decimal, resultPrecision Precision, m RoundingMode) (Decimal, error) {
+	if rhs.Sign() == 0 {
+		return Decimal{}, ErrDivisionByZero
+	}
+	return d.QuoRound(rhs, resultPrecision, m), nil
+}
+
+// TryMod is the same as Mod but returns ErrDivisionByZero instead of panicking.
+func (d Decimal) TryMod(rhs Decimal) (Decimal, error) {
+	if rhs.Sign() == 0 {
+		return Decimal{}, ErrDivisionByZero
+	}
+	return d.Mod(rhs), nil
+}
+
+// TryDivMod is the same as DivMod but returns ErrDivisionByZero instead of panicking.
+func (d Decimal) TryDivMod(rhs Decimal) (div, mod Decimal, err error) {
+	if rhs.Sign() == 0 {
+		return Decimal{}, Decimal{}, ErrDivisionByZero
+	}
+	div, mod = d.DivMod(rhs)
+	return div, mod, nil
+}
+
+// TryQuoTail is the same as QuoTail but returns ErrDivisionByZero instead of panicking.
+func (d Decimal) TryQuoTail(rhs Decimal) (quo, tail Decimal, err error) {
+	if rhs.Sign() == 0 {
+		return Decimal{}, Decimal{}, ErrDivisionByZero
+	}
+	quo, tail = d.QuoTail(rhs)
+	return quo, tail, nil
+}
+
+// TryDivTail is the same as DivTail but returns ErrDivisionByZero instead of panicking.
+func (d Decimal) TryDivTail(rhs Decimal) (div, tail Decimal, err error) {
+	if rhs.Sign() == 0 {
+		return Decimal{}, Decimal{}, ErrDivisionByZero
+	}
+	div, tail = d.DivTail(rhs)
+	return div, tail, nil
+}
+
+// TryPow is the same as Pow but returns ErrDivisionByZero for a negative power of zero.
+func (d Decimal) TryPow(n int64, resultPrecision Precision, m RoundingMode) (Decimal, error) {
+	if n < 0 && d.Sign() == 0 {
+		return Decimal{}, ErrDivisionByZero
+	}
+	return d.Pow(n, resultPrecision, m), nil
+}
@@ -0,0 +1,80 @@
+package dec
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_CheckedDivisionByZero(t *testing.T) {
+	n, zero := Nano.MustParse("1.5"), Milli.Zero()
+	checks := map[string]func() error{
+		"TryQuo": func() error { _, err := n.TryQuo(zero); return err },
+		"TryQuoRem": func() error {
+			_, _, err := n.TryQuoRem(zero)
+			return err
+		},
+		"TryDiv":      func() error { _, err := n.TryDiv(zero); return err },
+		"TryQuoRound": func() error { _, err := n.TryQuoRound(zero, Milli, HalfEven); return err },
+		"TryMod":      func() error { _, err := n.TryMod(zero); return err },
+		"TryDivMod": func() error {
+			_, _, err := n.TryDivMod(zero)
+			return err
+		},
+		"TryQuoTail": func() error {
+			_, _, err := n.TryQuoTail(Decimal{})
+			return err
+		},
+		"TryDivTail": func() error {
+			_, _, err := n.TryDivTail(zero)
+			return err
+		},
+		"DecimalMut.TryQuo": func() error { _, err := n.Copy().Var().TryQuo(zero); return err },
+		"DecimalMut.TryDivMod": func() error {
+			_, _, err := n.Copy().Var().TryDivMod(zero, nil)
+			return err
+		},
+	}
+	for name, check := range checks {
+		if err := check(); !errors.Is(err, ErrDivisionByZero) {
+			t.Fatalf("%s: expected ErrDivisionByZero, got %v", name, err)
+		}
+	}
+	if got, expected := n.String(), "1.5"; got != expected {
+		t.Fatalf("operand should stay unchanged, expected %s, got %s", expected, got)
+	}
+}
+
+func Test_CheckedNilDecimalMut(t *testing.T) {
+	var nilPtr *DecimalMut = nil
+	if _, err := nilPtr.TryAdd(Z.FromUInt64(2)); !errors.Is(err, ErrNilDecimalMut) {
+		t.Fatalf("expected ErrNilDecimalMut, got %v", err)
+	}
+	if _, err := nilPtr.TryQuo(Z.FromUInt64(2)); !errors.Is(err, ErrNilDecimalMut) {
+		t.Fatalf("expected ErrNilDecimalMut, got %v", err)
+	}
+}
+
+func Test_CheckedResults(t *testing.T) {
+	quo, err := Nano.FromUInt64(2).TryQuoRound(Z.FromUInt64(3), Milli, HalfUp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, expected := quo.String(), "0.667"; got != expected {
+		t.Fatalf("invalid TryQuoRound, expected %s, got %s", expected, got)
+	}
+	div, mod, err := Centi.MustParse("6.67").TryDivMod(Centi.MustParse("3.3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if div.String() != "2" || mod.String() != "0.07" {
+		t.Fatalf("invalid TryDivMod, got %s and %s", div, mod)
+	}
+}
+
+func Test_ContextDivisionByZeroError(t *testing.T) {
+	ctx := &Context{Precision: Centi, Traps: DivisionByZero}
+	ctx.Quo(Centi.One(), Centi.Zero())
+	if err := ctx.Err(); !errors.Is(err, ErrDivisionByZero) {
+		t.Fatalf("expected ErrDivisionByZero, got %v", err)
+	}
+}
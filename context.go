@@ -0,0 +1,162 @@
+package dec
+
+import "strings"
+
+// Signal is a set of exceptional conditions which could be raised by an arithmetic Context operation.
+type Signal uint8
+
+const (
+	Inexact          Signal = 1 << iota // the result was rounded and non-zero digits were discarded.
+	Rounded                             // the result was rounded (the discarded digits may be zeroes).
+	Overflow                            // the result does not fit into the Context.Fit size.
+	DivisionByZero                      // non-zero dividend was divided by zero.
+	InvalidOperation                    // zero was divided by zero.
+)
+
+var signalNames = [...]string{"Inexact", "Rounded", "Overflow", "DivisionByZero", "InvalidOperation"}
+
+func (s Signal) String() string {
+	if s == 0 {
+		return "None"
+	}
+	names := make([]string, 0, len(signalNames))
+	for i, name := range signalNames {
+		if s&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// Has reports whether all the signals of the set o are in the set s.
+func (s Signal) Has(o Signal) bool {
+	return s&o == o
+}
+
+// SignalError is returned by Context.Err when a trapped signal has been raised.
+type SignalError struct {
+	Signal Signal
+}
+
+func (e *SignalError) Error() string {
+	return "decimal signal trapped: " + e.Signal.String()
+}
+
+// Context holds the arithmetic settings which are applied to every result of its operations,
+// similar to decimal.Context of Python.
+// The raised signals are collected in the sticky Flags, a zero Fit means no size limit.
+// Context is not safe for concurrent use.
+type Context struct {
+	Precision Precision
+	Rounding  RoundingMode
+	Fit       FitSize
+	Traps     Signal
+	Flags     Signal
+	trapped   Signal
+}
+
+func NewContext(p Precision, m RoundingMode) *Context {
+	return &Context{Precision: p, Rounding: m}
+}
+
+// Err returns a *SignalError with all the trapped signals raised since the last ClearFlags call.
+func (c *Context) Err() error {
+	if c.trapped == 0 {
+		return nil
+	}
+	return &SignalError{Signal: c.trapped}
+}
+
+// ClearFlags resets the raised signals.
+func (c *Context) ClearFlags() {
+	c.Flags = 0
+	c.trapped = 0
+}
+
+func (c *Context) raise(s Signal) {
+	c.Flags |= s
+	c.trapped |= s & c.Traps
+}
+
+func (c *Context) Add(lhs, rhs Decimal) Decimal {
+	return c.finish(lhs.lhs().Add(rhs))
+}
+
+func (c *Context) Sub(lhs, rhs Decimal) Decimal {
+	return c.finish(lhs.lhs().Sub(rhs))
+}
+
+func (c *Context) Mul(lhs, rhs Decimal) Decimal {
+	if rhs.p == nil {
+		rhs.p = &DecimalMut{}
+	}
+	// the exact product has lhs.exp + rhs.exp decimal places, the sum could exceed the maximum precision.
+	product := lhs.lhs()
+	product.val.Mul(&product.val, &rhs.p.val)
+	return c.finishExp(product, int64(product.exp)+int64(rhs.p.exp))
+}
+
+// Quo returns the quotient lhs/rhs rounded to the Context precision.
+// Division by zero raises DivisionByZero (or InvalidOperation for 0/0) and results in zero.
+func (c *Context) Quo(lhs, rhs Decimal) Decimal {
+	if rhs.Sign() == 0 {
+		if lhs.Sign() == 0 {
+			c.raise(InvalidOperation)
+		} else {
+			c.raise(DivisionByZero)
+		}
+		return Zero(c.Precision)
+	}
+	quo := lhs.lhs()
+	if !quo.quoRound(rhs.p, c.Precision, c.mode()) {
+		c.raise(Inexact | Rounded)
+	}
+	return c.checkFit(quo)
+}
+
+// Round rounds d to the Context precision.
+func (c *Context) Round(d Decimal) Decimal {
+	return c.finish(d.lhs())
+}
+
+func (c *Context) Abs(d Decimal) Decimal {
+	return c.finish(d.lhs().Abs())
+}
+
+func (c *Context) Neg(d Decimal) Decimal {
+	return c.finish(d.lhs().Neg())
+}
+
+func (c *Context) mode() RoundingMode {
+	if !c.Rounding.valid() {
+		panic("invalid rounding mode")
+	}
+	return c.Rounding
+}
+
+// finish brings an exact result to the Context precision raising the corresponding signals.
+func (c *Context) finish(d *DecimalMut) Decimal {
+	return c.finishExp(d, int64(d.exp))
+}
+
+// finishExp is finish for the units of d with exp decimal places regardless of the precision of d.
+func (c *Context) finishExp(d *DecimalMut, exp int64) Decimal {
+	if exp > int64(c.Precision) {
+		c.raise(Rounded)
+		if !quoRound(&d.val, &d.val, pow10(exp-int64(c.Precision)), c.mode()) {
+			c.raise(Inexact)
+		}
+		d.exp = c.Precision
+	} else {
+		d.exp = Precision(exp)
+		d.Rescale(c.Precision)
+	}
+	return c.checkFit(d)
+}
+
+func (c *Context) checkFit(d *DecimalMut) Decimal {
+	if c.Fit != 0 && !checkNumberSize(&d.val, uint(c.Fit)) {
+		c.raise(Overflow)
+	}
+	return d.Val()
+}
@@ -0,0 +1,82 @@
+package dec
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_ContextRounding(t *testing.T) {
+	ctx := NewContext(Centi, HalfEven)
+	if got, expected := ctx.Add(Milli.MustParse("1.005"), Milli.MustParse("1.01")).String(), "2.02"; got != expected {
+		t.Fatalf("invalid Context.Add, expected %s, got %s", expected, got)
+	}
+	if !ctx.Flags.Has(Inexact | Rounded) {
+		t.Fatalf("Inexact and Rounded flags should be raised, got %s", ctx.Flags)
+	}
+	ctx.ClearFlags()
+
+	sum := ctx.Add(Milli.MustParse("1.1"), Z.FromUInt64(2))
+	if got, expected := sum.String(), "3.1"; got != expected {
+		t.Fatalf("invalid Context.Add, expected %s, got %s", expected, got)
+	}
+	if got, expected := sum.Precision(), Centi; got != expected {
+		t.Fatalf("invalid Context.Add precision, expected %d, got %d", expected, got)
+	}
+	if ctx.Flags != Rounded {
+		t.Fatalf("only Rounded flag should be raised, got %s", ctx.Flags)
+	}
+	ctx.ClearFlags()
+
+	if got, expected := ctx.Mul(Centi.MustParse("0.15"), Centi.MustParse("0.5")).String(), "0.08"; got != expected {
+		t.Fatalf("invalid Context.Mul, expected %s, got %s", expected, got)
+	}
+	if got, expected := ctx.Quo(Z.FromUInt64(2), Z.FromUInt64(3)).String(), "0.67"; got != expected {
+		t.Fatalf("invalid Context.Quo, expected %s, got %s", expected, got)
+	}
+	if got, expected := ctx.Round(Nano.MustParse("2.675")).String(), "2.68"; got != expected {
+		t.Fatalf("invalid Context.Round, expected %s, got %s", expected, got)
+	}
+	if err := ctx.Err(); err != nil {
+		t.Fatalf("no signals are trapped, but got error %v", err)
+	}
+	ctx.ClearFlags()
+
+	// the precisions of the factors add up beyond the maximum precision.
+	fine := FromUnitsInt64(15, 40000)
+	if got := ctx.Mul(fine, fine); got.Sign() != 0 || got.Precision() != Centi || ctx.Flags != Inexact|Rounded {
+		t.Fatalf("invalid Context.Mul of the fine factors, got %s with precision %d and flags %s", got, got.Precision(), ctx.Flags)
+	}
+	ctx.Rounding = ToPositiveInf
+	if got, expected := ctx.Mul(fine, fine).String(), "0.01"; got != expected {
+		t.Fatalf("invalid Context.Mul of the fine factors rounded up, expected %s, got %s", expected, got)
+	}
+	if got, expected := ctx.Mul(fine, FromUnits(pow10(70000), 30000)).String(), "15"; got != expected {
+		t.Fatalf("invalid exact Context.Mul of the fine factors, expected %s, got %s", expected, got)
+	}
+}
+
+func Test_ContextSignals(t *testing.T) {
+	ctx := &Context{Precision: Nano, Rounding: HalfEven, Fit: Fit64, Traps: DivisionByZero | Overflow}
+	if got := ctx.Quo(Nano.One(), Nano.Zero()); got.Sign() != 0 || got.Precision() != Nano {
+		t.Fatalf("division by zero should result in zero, got %s", got)
+	}
+	if ctx.Quo(Nano.Zero(), Nano.Zero()); !ctx.Flags.Has(InvalidOperation | DivisionByZero) {
+		t.Fatalf("InvalidOperation and DivisionByZero flags should be raised, got %s", ctx.Flags)
+	}
+	var sigErr *SignalError
+	if err := ctx.Err(); !errors.As(err, &sigErr) || sigErr.Signal != DivisionByZero {
+		t.Fatalf("only DivisionByZero should be trapped, got %v", err)
+	}
+
+	ctx.ClearFlags()
+	if ctx.Mul(Nano.FromUnits(Max64BitsValue), Z.FromUInt64(2)); ctx.Flags != Overflow {
+		t.Fatalf("Overflow flag should be raised, got %s", ctx.Flags)
+	}
+	if err := ctx.Err(); !errors.As(err, &sigErr) || sigErr.Signal != Overflow {
+		t.Fatalf("Overflow should be trapped, got %v", err)
+	}
+	ctx.ClearFlags()
+	if ctx.Flags != 0 || ctx.Err() != nil {
+		t.Fatal("ClearFlags should reset the signals")
+	}
+}
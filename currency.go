@@ -0,0 +1,64 @@
+package dec
+
+import (
+	"strings"
+
+	"github.com/pr0n1x/go-liners/werr"
+)
+
+var ErrUnknownCurrency = werr.New("unknown currency")
+
+// Currency is an ISO 4217 alphabetic currency code.
+type Currency string
+
+// iso4217 maps the active ISO 4217 currencies to the precisions of their minor units.
+var iso4217 = map[Currency]Precision{
+	"AED": Centi, "AFN": Centi, "ALL": Centi, "AMD": Centi, "ANG": Centi, "AOA": Centi, "ARS": Centi,
+	"AUD": Centi, "AWG": Centi, "AZN": Centi, "BAM": Centi, "BBD": Centi, "BDT": Centi, "BGN": Centi,
+	"BHD": Milli, "BIF": Z, "BMD": Centi, "BND": Centi, "BOB": Centi, "BOV": Centi, "BRL": Centi,
+	"BSD": Centi, "BTN": Centi, "BWP": Centi, "BYN": Centi, "BZD": Centi, "CAD": Centi, "CDF": Centi,
+	"CHE": Centi, "CHF": Centi, "CHW": Centi, "CLF": 4, "CLP": Z, "CNY": Centi, "COP": Centi,
+	"COU": Centi, "CRC": Centi, "CUP": Centi, "CVE": Centi, "CZK": Centi, "DJF": Z, "DKK": Centi,
+	"DOP": Centi, "DZD": Centi, "EGP": Centi, "ERN": Centi, "ETB": Centi, "EUR": Centi, "FJD": Centi,
+	"FKP": Centi, "GBP": Centi, "GEL": Centi, "GHS": Centi, "GIP": Centi, "GMD": Centi, "GNF": Z,
+	"GTQ": Centi, "GYD": Centi, "HKD": Centi, "HNL": Centi, "HTG": Centi, "HUF": Centi, "IDR": Centi,
+	"ILS": Centi, "INR": Centi, "IQD": Milli, "IRR": Centi, "ISK": Z, "JMD": Centi, "JOD": Milli,
+	"JPY": Z, "KES": Centi, "KGS": Centi, "KHR": Centi, "KMF": Z, "KPW": Centi, "KRW": Z,
+	"KWD": Milli, "KYD": Centi, "KZT": Centi, "LAK": Centi, "LBP": Centi, "LKR": Centi, "LRD": Centi,
+	"LSL": Centi, "LYD": Milli, "MAD": Centi, "MDL": Centi, "MGA": Centi, "MKD": Centi, "MMK": Centi,
+	"MNT": Centi, "MOP": Centi, "MRU": Centi, "MUR": Centi, "MVR": Centi, "MWK": Centi, "MXN": Centi,
+	"MXV": Centi, "MYR": Centi, "MZN": Centi, "NAD": Centi, "NGN": Centi, "NIO": Centi, "NOK": Centi,
+	"NPR": Centi, "NZD": Centi, "OMR": Milli, "PAB": Centi, "PEN": Centi, "PGK": Centi, "PHP": Centi,
+	"PKR": Centi, "PLN": Centi, "PYG": Z, "QAR": Centi, "RON": Centi, "RSD": Centi, "RUB": Centi,
+	"RWF": Z, "SAR": Centi, "SBD": Centi, "SCR": Centi, "SDG": Centi, "SEK": Centi, "SGD": Centi,
+	"SHP": Centi, "SLE": Centi, "SOS": Centi, "SRD": Centi, "SSP": Centi, "STN": Centi, "SVC": Centi,
+	"SYP": Centi, "SZL": Centi, "THB": Centi, "TJS": Centi, "TMT": Centi, "TND": Milli, "TOP": Centi,
+	"TRY": Centi, "TTD": Centi, "TWD": Centi, "TZS": Centi, "UAH": Centi, "UGX": Z, "USD": Centi,
+	"USN": Centi, "UYI": Z, "UYU": Centi, "UYW": 4, "UZS": Centi, "VED": Centi, "VES": Centi,
+	"VND": Z, "VUV": Z, "WST": Centi, "XAF": Z, "XCD": Centi, "XCG": Centi, "XOF": Z,
+	"XPF": Z, "YER": Centi, "ZAR": Centi, "ZMW": Centi, "ZWG": Centi,
+}
+
+// ParseCurrency returns the known currency of the case-insensitive code.
+func ParseCurrency(code string) (Currency, error) {
+	c := Currency(strings.ToUpper(strings.TrimSpace(code)))
+	if _, ok := iso4217[c]; !ok {
+		return "", ErrUnknownCurrency.Explainf("%q", code)
+	}
+	return c, nil
+}
+
+// Precision returns the precision of the minor unit of the currency: Z for JPY, Centi for USD, Milli for BHD.
+func (c Currency) Precision() (Precision, error) {
+	p, ok := iso4217[c]
+	if !ok {
+		return 0, ErrUnknownCurrency.Explainf("%q", string(c))
+	}
+	return p, nil
+}
+
+// Known reports whether the currency is in the ISO 4217 table.
+func (c Currency) Known() bool {
+	_, ok := iso4217[c]
+	return ok
+}
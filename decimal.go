@@ -12,8 +12,6 @@ type Decimal struct {
 
 const BASE = 10
 
-// TODO: add methods Ceil, Floor, Round, Pow, Avg(first Decimal, rest ...Decimal).
-
 func Zero(p Precision) Decimal {
 	return FromUnitsUInt64(0, p)
 }
@@ -159,56 +157,9 @@ func FromInt64(val int64, precision Precision) Decimal {
 	return Decimal{p: NewDecimalMut(value, precision)}
 }
 
-// Parse parses decimal number.
+// Parse parses decimal number using DefaultParsing, see ParseOptions.Parse.
 func Parse(val string, precision Precision, limitPrecision bool) (Decimal, error) {
-	s := strings.SplitN(val, ".", 2)
-
-	if len(s) == 0 || len(s) > 2 {
-		return Decimal{}, ErrInvalidDecimalString
-	}
-
-	hi, ok := (&big.Int{}).SetString(s[0], 10)
-	if !ok {
-		return Decimal{}, ErrInvalidDecimalString
-	}
-
-	if len(s) < 2 {
-		hi = hi.Mul(hi, (&big.Int{}).Exp(big.NewInt(10), big.NewInt(int64(precision)), nil))
-	} else {
-		loStr := s[1]
-		// lo can have max {decimals} digits.
-		if len(loStr) > int(precision) {
-			if limitPrecision {
-				loStr = loStr[:precision]
-			} else {
-				precision = Precision(len(loStr))
-			}
-		}
-		hi = hi.Mul(hi, (&big.Int{}).Exp(big.NewInt(10), big.NewInt(int64(precision)), nil))
-
-		leadZeroes := 0
-		for _, sym := range loStr {
-			if sym != '0' {
-				break
-			}
-			leadZeroes++
-		}
-
-		lo, ok := (&big.Int{}).SetString(loStr, 10)
-		if !ok {
-			return Decimal{}, ErrInvalidDecimalString
-		}
-
-		digits := len(lo.String()) // =_=.
-		lo = lo.Mul(lo, (&big.Int{}).Exp(big.NewInt(10), big.NewInt(int64((int(precision)-leadZeroes)-digits)), nil))
-		if hi.Sign() < 0 && lo.Sign() > 0 {
-			hi = hi.Sub(hi, lo)
-		} else {
-			hi = hi.Add(hi, lo)
-		}
-	}
-
-	return FromUnits(hi, precision), nil
+	return DefaultParsing.Parse(val, precision, limitPrecision)
 }
 
 // MustParse the same as ParsePrecise but panics on error.
@@ -218,10 +169,14 @@ func MustParse(val string, precision Precision, limitedPrecision bool) Decimal {
 
 // ParseUnits parse a string of whole number containing rescaled and remainder part of the value.
 func ParseUnits(val string, precision Precision) (Decimal, error) {
-	if bn, ok := (&big.Int{}).SetString(val, 10); ok {
-		return FromUnits(bn, precision), nil
+	if i := strings.IndexByte(val, '.'); i >= 0 {
+		return Decimal{}, &ParseError{Input: val, Offset: i, Reason: "units should be a whole number"}
+	}
+	units, err := DefaultParsing.Parse(val, Z, false)
+	if err != nil {
+		return Decimal{}, err
 	}
-	return Decimal{}, ErrInvalidDecimalString
+	return FromUnits(units.Units(), precision), nil
 }
 
 // MustParseUnits the same as ParseUnits but panics on error.
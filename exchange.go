@@ -0,0 +1,172 @@
+package dec
+
+import (
+	"sync"
+
+	"github.com/pr0n1x/go-liners/werr"
+)
+
+var (
+	ErrNoExchangeRate = werr.New("no exchange rate")
+	ErrInvalidQuote   = werr.New("exchange rates should be positive and the bid should not exceed the ask")
+)
+
+// Side selects the price of a quote used for a conversion.
+type Side uint8
+
+const (
+	Dealing Side = iota // the bid when the base currency of the quote is sold, the ask when it's bought.
+	MidRate             // the middle of the bid and the ask.
+)
+
+// Quote is a price of one unit of the base currency in the quote currency.
+type Quote struct {
+	Bid Decimal
+	Ask Decimal
+}
+
+type currencyPair struct {
+	base, quote Currency
+}
+
+// RateTable keeps the exchange rates and converts Money between currencies.
+// A conversion uses the direct quote, the inverted reverse one or triangulates through the Base currency.
+// The inverted and the cross rates are rounded to the table Precision using the HalfEven mode,
+// the converted amounts are rounded to the minor unit of the target currency using its rounding mode.
+// RateTable is safe for concurrent use.
+type RateTable struct {
+	Base      Currency
+	Precision Precision
+
+	mu       sync.RWMutex
+	quotes   map[currencyPair]Quote
+	rounding map[Currency]RoundingMode
+}
+
+// Conversion is a result of the conversion with the data to reconcile it:
+// Source.Amount * Rate == Result.Amount + Residual exactly.
+type Conversion struct {
+	Source   Money
+	Result   Money
+	Rate     Decimal    // the rate used for the conversion.
+	Residual Decimal    // the rounded off part of the converted amount in the target currency.
+	Path     []Currency // the source currency, the base one when triangulated and the target currency.
+}
+
+// NewRateTable returns an empty table triangulating through the base currency
+// and keeping the computed rates with the precision p.
+func NewRateTable(base Currency, p Precision) *RateTable {
+	return &RateTable{Base: base, Precision: p}
+}
+
+// Set sets the bid and the ask prices of one unit of the base currency in the quote currency.
+func (t *RateTable) Set(base, quote Currency, bid, ask Decimal) error {
+	for _, c := range []Currency{base, quote} {
+		if !c.Known() {
+			return ErrUnknownCurrency.Explainf("%q", string(c))
+		}
+	}
+	if base == quote || bid.Sign() <= 0 || bid.Cmp(ask) > 0 {
+		return ErrInvalidQuote.Explainf("%s/%s %s %s", base, quote, bid, ask)
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.quotes == nil {
+		t.quotes = make(map[currencyPair]Quote)
+	}
+	t.quotes[currencyPair{base, quote}] = Quote{Bid: bid, Ask: ask}
+	return nil
+}
+
+// SetMid sets the same bid and ask price of one unit of the base currency in the quote currency.
+func (t *RateTable) SetMid(base, quote Currency, mid Decimal) error {
+	return t.Set(base, quote, mid, mid)
+}
+
+// SetRounding sets the rounding mode of the amounts converted to the currency, HalfEven is used by default.
+func (t *RateTable) SetRounding(c Currency, m RoundingMode) {
+	if !m.valid() {
+		panic("invalid rounding mode")
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.rounding == nil {
+		t.rounding = make(map[Currency]RoundingMode)
+	}
+	t.rounding[c] = m
+}
+
+// Rate returns the rate converting the from currency to the to one and the conversion path.
+func (t *RateTable) Rate(from, to Currency, side Side) (Decimal, []Currency, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.rate(from, to, side)
+}
+
+// Convert converts the amount to the currency.
+func (t *RateTable) Convert(amount Money, to Currency, side Side) (Conversion, error) {
+	p, err := to.Precision()
+	if err != nil {
+		return Conversion{}, err
+	}
+	t.mu.RLock()
+	rate, path, err := t.rate(amount.Currency, to, side)
+	m, ok := t.rounding[to]
+	t.mu.RUnlock()
+	if err != nil {
+		return Conversion{}, err
+	}
+	if !ok {
+		m = HalfEven
+	}
+	exact := amount.Amount.MulRound(rate, amount.Amount.Precision()+rate.Precision(), ToZero)
+	converted := roundTo(exact, p, m)
+	return Conversion{
+		Source:   amount,
+		Result:   Money{Amount: converted, Currency: to},
+		Rate:     rate,
+		Residual: exact.Sub(converted),
+		Path:     path,
+	}, nil
+}
+
+// rate is Rate without locking.
+func (t *RateTable) rate(from, to Currency, side Side) (Decimal, []Currency, error) {
+	if from == to {
+		if !from.Known() {
+			return Decimal{}, nil, ErrUnknownCurrency.Explainf("%q", string(from))
+		}
+		return One(Z), []Currency{from}, nil
+	}
+	if rate, ok := t.leg(from, to, side); ok {
+		return rate, []Currency{from, to}, nil
+	}
+	if from != t.Base && to != t.Base {
+		first, ok1 := t.leg(from, t.Base, side)
+		second, ok2 := t.leg(t.Base, to, side)
+		if ok1 && ok2 {
+			cross := first.MulRound(second, t.Precision, HalfEven)
+			return cross, []Currency{from, t.Base, to}, nil
+		}
+	}
+	return Decimal{}, nil, ErrNoExchangeRate.Explainf("%s to %s", from, to)
+}
+
+// leg returns the rate of the direct quote or the inverted reverse one.
+func (t *RateTable) leg(from, to Currency, side Side) (Decimal, bool) {
+	if q, ok := t.quotes[currencyPair{from, to}]; ok {
+		if side == MidRate {
+			return q.Bid.Add(q.Ask).QuoRound(FromInt64(2, Z), max(q.Bid.Precision(), q.Ask.Precision())+1, HalfEven), true
+		}
+		return q.Bid, true
+	}
+	if q, ok := t.quotes[currencyPair{to, from}]; ok {
+		price := q.Ask
+		if side == MidRate {
+			price = q.Bid.Add(q.Ask)
+			return FromInt64(2, Z).QuoRound(price, t.Precision, HalfEven), true
+		}
+		return One(Z).QuoRound(price, t.Precision, HalfEven), true
+	}
+	return Decimal{}, false
+}
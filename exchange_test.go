@@ -0,0 +1,89 @@
+package dec
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func newTestRateTable(t *testing.T) *RateTable {
+	table := NewRateTable("USD", 10)
+	if err := table.Set("EUR", "USD", MustParse("1.085", 4, true), MustParse("1.0852", 4, true)); err != nil {
+		t.Fatal(err)
+	}
+	if err := table.Set("USD", "JPY", Centi.MustParse("151.2"), Centi.MustParse("151.25")); err != nil {
+		t.Fatal(err)
+	}
+	if err := table.SetMid("GBP", "EUR", MustParse("1.17", 4, true)); err != nil {
+		t.Fatal(err)
+	}
+	return table
+}
+
+func Test_RateTableConvert(t *testing.T) {
+	table := newTestRateTable(t)
+	table.SetRounding("JPY", ToZero)
+	for _, tc := range []struct {
+		amount   Money
+		to       Currency
+		side     Side
+		result   string
+		rate     string
+		residual string
+		path     []Currency
+	}{
+		{MustParseMoney("100", "EUR"), "USD", Dealing, "108.5 USD", "1.085", "0", []Currency{"EUR", "USD"}},
+		{MustParseMoney("100", "EUR"), "USD", MidRate, "108.51 USD", "1.0851", "0", []Currency{"EUR", "USD"}},
+		{MustParseMoney("100", "USD"), "EUR", Dealing, "92.15 EUR", "0.9214891264", "-0.00108736", []Currency{"USD", "EUR"}},
+		{MustParseMoney("1000", "EUR"), "JPY", Dealing, "164052 JPY", "164.052", "0", []Currency{"EUR", "USD", "JPY"}},
+		{MustParseMoney("100000", "JPY"), "EUR", Dealing, "609.25 EUR", "0.00609249", "-0.001", []Currency{"JPY", "USD", "EUR"}},
+		{MustParseMoney("12345", "JPY"), "USD", MidRate, "81.63 USD", "0.0066126633", "0.0033284385", []Currency{"JPY", "USD"}},
+		{MustParseMoney("0.99", "USD"), "JPY", Dealing, "149 JPY", "151.2", "0.688", []Currency{"USD", "JPY"}},
+		{MustParseMoney("10", "GBP"), "EUR", Dealing, "11.7 EUR", "1.17", "0", []Currency{"GBP", "EUR"}},
+		{MustParseMoney("10", "GBP"), "GBP", Dealing, "10 GBP", "1", "0", []Currency{"GBP"}},
+	} {
+		conversion, err := table.Convert(tc.amount, tc.to, tc.side)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := conversion.Result.String(); got != tc.result {
+			t.Fatalf("invalid conversion of %s to %s, expected %s, got %s", tc.amount, tc.to, tc.result, got)
+		}
+		if got := conversion.Rate.String(); got != tc.rate {
+			t.Fatalf("invalid rate of %s to %s, expected %s, got %s", tc.amount, tc.to, tc.rate, got)
+		}
+		if got := conversion.Residual.String(); got != tc.residual {
+			t.Fatalf("invalid residual of %s to %s, expected %s, got %s", tc.amount, tc.to, tc.residual, got)
+		}
+		if !slices.Equal(conversion.Path, tc.path) {
+			t.Fatalf("invalid path of %s to %s, expected %v, got %v", tc.amount, tc.to, tc.path, conversion.Path)
+		}
+		exact := tc.amount.Amount.MulRound(conversion.Rate, 20, ToZero)
+		if exact.Cmp(conversion.Result.Amount.Add(conversion.Residual)) != 0 {
+			t.Fatalf("conversion of %s to %s doesn't reconcile", tc.amount, tc.to)
+		}
+	}
+}
+
+func Test_RateTableErrors(t *testing.T) {
+	table := newTestRateTable(t)
+	if err := table.Set("EUR", "USD", One(Z), Deci.MustParse("0.9")); !errors.Is(err, ErrInvalidQuote) {
+		t.Fatalf("expected ErrInvalidQuote, got %v", err)
+	}
+	if err := table.SetMid("EUR", "USD", Zero(Z)); !errors.Is(err, ErrInvalidQuote) {
+		t.Fatalf("expected ErrInvalidQuote, got %v", err)
+	}
+	if err := table.SetMid("EUR", "XYZ", One(Z)); !errors.Is(err, ErrUnknownCurrency) {
+		t.Fatalf("expected ErrUnknownCurrency, got %v", err)
+	}
+	// GBP is quoted against EUR only, so there is no path to JPY through USD.
+	if _, err := table.Convert(MustParseMoney("1", "GBP"), "JPY", Dealing); !errors.Is(err, ErrNoExchangeRate) {
+		t.Fatalf("expected ErrNoExchangeRate, got %v", err)
+	}
+	if _, _, err := table.Rate("CHF", "USD", MidRate); !errors.Is(err, ErrNoExchangeRate) {
+		t.Fatalf("expected ErrNoExchangeRate, got %v", err)
+	}
+	if _, err := table.Convert(MustParseMoney("1", "USD"), "XYZ", Dealing); !errors.Is(err, ErrUnknownCurrency) {
+		t.Fatalf("expected ErrUnknownCurrency, got %v", err)
+	}
+}
@@ -0,0 +1,102 @@
+package finance
+
+import (
+	"math/big"
+
+	dec "github.com/pr0n1x/decimal-go"
+	"github.com/pr0n1x/go-liners/werr"
+)
+
+var ErrInvalidFrequency = werr.New("payment frequency should be positive")
+
+// Frequency is a number of payments per year.
+type Frequency int64
+
+const (
+	Annual     Frequency = 1
+	SemiAnnual Frequency = 2
+	Quarterly  Frequency = 4
+	Monthly    Frequency = 12
+	Biweekly   Frequency = 26
+	Weekly     Frequency = 52
+)
+
+// Method is a way to repay a loan.
+type Method uint8
+
+const (
+	Annuity        Method = iota // equal payments of the interest and the principal.
+	EqualPrincipal               // equal parts of the principal plus the interest on the remaining balance.
+)
+
+// Loan describes a loan repaid by the payments at the end of each period.
+type Loan struct {
+	Principal dec.Decimal
+	Rate      dec.Decimal // the nominal annual rate, it's divided by the Frequency to get the rate per period.
+	Payments  int64       // the term as a number of payments.
+	Frequency Frequency
+	Method    Method
+	Precision dec.Precision    // the currency precision of the amounts.
+	Rounding  dec.RoundingMode // the rounding of the payment, the interest and the principal parts.
+}
+
+// Installment is a row of an amortization schedule.
+type Installment struct {
+	Period    int64 // the number of the payment starting from 1.
+	Payment   dec.Decimal
+	Interest  dec.Decimal
+	Principal dec.Decimal
+	Balance   dec.Decimal // the remaining balance after the payment.
+}
+
+// Schedule returns the amortization schedule of the loan, the amounts have the sign of the principal.
+// The payment, the interest and the principal parts are rounded to the loan Precision using the Rounding mode,
+// the last payment absorbs the rounding residual, so the balance ends at exactly zero.
+func (l Loan) Schedule() ([]Installment, error) {
+	if l.Frequency < 1 {
+		return nil, ErrInvalidFrequency
+	}
+	if l.Payments < 1 {
+		return nil, ErrInvalidPeriods
+	}
+	rate := toRat(l.Rate)
+	rate.Quo(rate, new(big.Rat).SetInt64(int64(l.Frequency)))
+	if rate.Cmp(new(big.Rat).Neg(ratOne)) <= 0 {
+		return nil, ErrInvalidRate
+	}
+
+	principal := l.Principal.Round(l.Precision, l.Rounding).Rescale(l.Precision)
+	var payment, part dec.Decimal
+	switch l.Method {
+	case Annuity:
+		// payment = principal * f / a.
+		f, a := annuityRat(rate, l.Payments, EndOfPeriod)
+		payment = roundRat(f.Mul(f, toRat(principal)).Quo(f, a), l.Precision, l.Rounding)
+	case EqualPrincipal:
+		part = principal.QuoRound(dec.FromInt64(l.Payments, dec.Z), l.Precision, l.Rounding)
+	default:
+		panic("invalid amortization method")
+	}
+
+	schedule := make([]Installment, l.Payments)
+	balance := principal
+	for i := range schedule {
+		row := &schedule[i]
+		row.Period = int64(i + 1)
+		row.Interest = roundRat(new(big.Rat).Mul(toRat(balance), rate), l.Precision, l.Rounding)
+		switch {
+		case row.Period == l.Payments:
+			row.Principal = balance
+			row.Payment = row.Interest.Add(balance)
+		case l.Method == Annuity:
+			row.Payment = payment
+			row.Principal = payment.Sub(row.Interest)
+		default:
+			row.Principal = part
+			row.Payment = part.Add(row.Interest)
+		}
+		balance = balance.Sub(row.Principal)
+		row.Balance = balance
+	}
+	return schedule, nil
+}
@@ -0,0 +1,87 @@
+package finance
+
+import (
+	"errors"
+	"testing"
+
+	dec "github.com/pr0n1x/decimal-go"
+)
+
+func Test_Schedule(t *testing.T) {
+	for _, tc := range []struct {
+		loan     Loan
+		payments []string
+		last     Installment
+	}{
+		{
+			loan: Loan{
+				Principal: dec.FromInt64(10000, dec.Z), Rate: dec.Centi.MustParse("0.12"), Payments: 12,
+				Frequency: Monthly, Method: Annuity, Precision: dec.Centi, Rounding: dec.HalfEven,
+			},
+			payments: []string{"888.49", "888.49", "888.49", "888.49", "888.49", "888.49", "888.49", "888.49", "888.49", "888.49", "888.49", "888.47"},
+			last:     Installment{Period: 12, Payment: dec.Centi.MustParse("888.47"), Interest: dec.Centi.MustParse("8.8"), Principal: dec.Centi.MustParse("879.67")},
+		},
+		{
+			loan: Loan{
+				Principal: dec.FromInt64(1000, dec.Z), Rate: dec.Deci.MustParse("0.1"), Payments: 3,
+				Frequency: Annual, Method: EqualPrincipal, Precision: dec.Centi, Rounding: dec.HalfUp,
+			},
+			payments: []string{"433.33", "400", "366.67"},
+			last:     Installment{Period: 3, Payment: dec.Centi.MustParse("366.67"), Interest: dec.Centi.MustParse("33.33"), Principal: dec.Centi.MustParse("333.34")},
+		},
+		{
+			loan: Loan{
+				Principal: dec.FromInt64(100, dec.Z), Rate: dec.Zero(dec.Z), Payments: 3,
+				Frequency: Quarterly, Method: Annuity, Precision: dec.Centi, Rounding: dec.ToZero,
+			},
+			payments: []string{"33.33", "33.33", "33.34"},
+			last:     Installment{Period: 3, Payment: dec.Centi.MustParse("33.34"), Interest: dec.Zero(dec.Centi), Principal: dec.Centi.MustParse("33.34")},
+		},
+	} {
+		schedule, err := tc.loan.Schedule()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(schedule) != len(tc.payments) {
+			t.Fatalf("invalid schedule length, expected %d, got %d", len(tc.payments), len(schedule))
+		}
+		balance := tc.loan.Principal
+		for i, row := range schedule {
+			if got, expected := row.Payment.String(), tc.payments[i]; got != expected {
+				t.Fatalf("invalid payment %d, expected %s, got %s", row.Period, expected, got)
+			}
+			if row.Payment.Cmp(row.Interest.Add(row.Principal)) != 0 {
+				t.Fatalf("payment %d is not equal to the interest plus the principal", row.Period)
+			}
+			if balance = balance.Sub(row.Principal); balance.Cmp(row.Balance) != 0 {
+				t.Fatalf("invalid balance after the payment %d, expected %s, got %s", row.Period, balance, row.Balance)
+			}
+		}
+		last := schedule[len(schedule)-1]
+		if last.Period != tc.last.Period || last.Payment.Cmp(tc.last.Payment) != 0 ||
+			last.Interest.Cmp(tc.last.Interest) != 0 || last.Principal.Cmp(tc.last.Principal) != 0 {
+			t.Fatalf("invalid last installment, expected %+v, got %+v", tc.last, last)
+		}
+		if last.Balance.Sign() != 0 || last.Balance.Precision() != dec.Centi {
+			t.Fatalf("the balance should end at exactly zero, got %s", last.Balance)
+		}
+	}
+}
+
+func Test_ScheduleErrors(t *testing.T) {
+	loan := Loan{Principal: dec.FromInt64(100, dec.Z), Rate: dec.Deci.MustParse("0.1"), Payments: 12, Frequency: Monthly}
+	for _, tc := range []struct {
+		modify   func(*Loan)
+		expected error
+	}{
+		{func(l *Loan) { l.Frequency = 0 }, ErrInvalidFrequency},
+		{func(l *Loan) { l.Payments = 0 }, ErrInvalidPeriods},
+		{func(l *Loan) { l.Rate = dec.FromInt64(-12, dec.Z) }, ErrInvalidRate},
+	} {
+		l := loan
+		tc.modify(&l)
+		if _, err := l.Schedule(); !errors.Is(err, tc.expected) {
+			t.Fatalf("expected %v, got %v", tc.expected, err)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Package finance computes the time value of money on decimal values.
+// The closed form functions are computed exactly and rounded only once to the requested precision,
+// the rates of return are found by the iterative solvers.
+// The cash flows follow the spreadsheet sign convention: the paid out money is negative.
+package finance
+
+import (
+	"math/big"
+
+	dec "github.com/pr0n1x/decimal-go"
+	"github.com/pr0n1x/go-liners/werr"
+)
+
+var (
+	ErrInvalidRate    = werr.New("rate should be greater than -1")
+	ErrInvalidPeriods = werr.New("number of periods should be positive")
+	ErrNoSignChange   = werr.New("cash flows should contain both positive and negative values")
+	ErrNoConvergence  = werr.New("solver did not converge")
+)
+
+// Timing is a moment within a period when the payments are made.
+type Timing uint8
+
+const (
+	EndOfPeriod       Timing = iota // payments are due at the end of each period (ordinary annuity).
+	BeginningOfPeriod               // payments are due at the beginning of each period (annuity due).
+)
+
+var ratOne = big.NewRat(1, 1)
+
+// PV returns the present value of nper periodic payments pmt and the final amount fv at the rate per period.
+func PV(rate dec.Decimal, nper int64, pmt, fv dec.Decimal, when Timing, p dec.Precision, m dec.RoundingMode) (dec.Decimal, error) {
+	f, a, err := annuity(rate, nper, when)
+	if err != nil {
+		return dec.Decimal{}, err
+	}
+	// pv = -(fv + pmt*a) / f.
+	pv := new(big.Rat).Mul(toRat(pmt), a)
+	pv.Add(pv, toRat(fv)).Quo(pv, f).Neg(pv)
+	return roundRat(pv, p, m), nil
+}
+
+// FV returns the future value of the present amount pv and nper periodic payments pmt at the rate per period.
+func FV(rate dec.Decimal, nper int64, pmt, pv dec.Decimal, when Timing, p dec.Precision, m dec.RoundingMode) (dec.Decimal, error) {
+	f, a, err := annuity(rate, nper, when)
+	if err != nil {
+		return dec.Decimal{}, err
+	}
+	// fv = -(pv*f + pmt*a).
+	fv := new(big.Rat).Mul(toRat(pmt), a)
+	fv.Add(fv, f.Mul(f, toRat(pv))).Neg(fv)
+	return roundRat(fv, p, m), nil
+}
+
+// PMT returns the periodic payment which repays the present amount pv over nper periods
+// at the rate per period leaving the final amount fv.
+func PMT(rate dec.Decimal, nper int64, pv, fv dec.Decimal, when Timing, p dec.Precision, m dec.RoundingMode) (dec.Decimal, error) {
+	f, a, err := annuity(rate, nper, when)
+	if err != nil {
+		return dec.Decimal{}, err
+	}
+	// pmt = -(fv + pv*f) / a.
+	pmt := f.Mul(f, toRat(pv))
+	pmt.Add(pmt, toRat(fv)).Quo(pmt, a).Neg(pmt)
+	return roundRat(pmt, p, m), nil
+}
+
+// NPV returns the net present value of the periodic cash flows at the rate per period,
+// the first value is discounted by one period as in spreadsheets.
+// Add the undiscounted initial investment to the result to get the value at the moment of the first cash flow.
+func NPV(rate dec.Decimal, values []dec.Decimal, p dec.Precision, m dec.RoundingMode) (dec.Decimal, error) {
+	if rate.Cmp(dec.One(dec.Z).Neg()) <= 0 {
+		return dec.Decimal{}, ErrInvalidRate
+	}
+	factor := new(big.Rat).Add(ratOne, toRat(rate))
+	npv := new(big.Rat)
+	for i := len(values) - 1; i >= 0; i-- {
+		npv.Add(npv, toRat(values[i])).Quo(npv, factor)
+	}
+	return roundRat(npv, p, m), nil
+}
+
+// annuity returns the compound factor (1+rate)^nper
+// and the annuity factor (1 + rate*when) * ((1+rate)^nper - 1) / rate which is nper for the zero rate.
+func annuity(rate dec.Decimal, nper int64, when Timing) (f, a *big.Rat, err error) {
+	if rate.Cmp(dec.One(dec.Z).Neg()) <= 0 {
+		return nil, nil, ErrInvalidRate
+	}
+	if nper < 1 {
+		return nil, nil, ErrInvalidPeriods
+	}
+	f, a = annuityRat(toRat(rate), nper, when)
+	return f, a, nil
+}
+
+// annuityRat is annuity for the validated rate and number of periods.
+func annuityRat(r *big.Rat, nper int64, when Timing) (f, a *big.Rat) {
+	f = compound(r, nper)
+	if r.Sign() == 0 {
+		return f, new(big.Rat).SetInt64(nper)
+	}
+	a = new(big.Rat).Sub(f, ratOne)
+	a.Quo(a, r)
+	if when == BeginningOfPeriod {
+		a.Mul(a, new(big.Rat).Add(ratOne, r))
+	}
+	return f, a
+}
+
+// compound returns (1+r)^n for a non-negative n.
+func compound(r *big.Rat, n int64) *big.Rat {
+	base := new(big.Rat).Add(ratOne, r)
+	exp := big.NewInt(n)
+	return new(big.Rat).SetFrac(new(big.Int).Exp(base.Num(), exp, nil), new(big.Int).Exp(base.Denom(), exp, nil))
+}
+
+func toRat(d dec.Decimal) *big.Rat {
+	return new(big.Rat).SetFrac(d.Units(), d.Precision().Multiplier())
+}
+
+// roundRat returns x rounded to p using the mode m.
+func roundRat(x *big.Rat, p dec.Precision, m dec.RoundingMode) dec.Decimal {
+	return dec.FromUnits(x.Num(), dec.Z).QuoRound(dec.FromUnits(x.Denom(), dec.Z), p, m)
+}
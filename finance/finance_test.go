@@ -0,0 +1,109 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	dec "github.com/pr0n1x/decimal-go"
+)
+
+func must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func parse(values ...string) []dec.Decimal {
+	decimals := make([]dec.Decimal, len(values))
+	for i, v := range values {
+		decimals[i] = dec.Centi.MustParse(v)
+	}
+	return decimals
+}
+
+func Test_TimeValue(t *testing.T) {
+	zero := dec.Zero(dec.Z)
+	for _, tc := range []struct {
+		name     string
+		got      dec.Decimal
+		expected string
+	}{
+		{"PMT", must(PMT(dec.Deci.MustParse("0.1"), 10, dec.FromInt64(10000, dec.Z), zero, EndOfPeriod, dec.Centi, dec.HalfEven)), "-1627.45"},
+		{"PMT zero rate", must(PMT(zero, 12, dec.FromInt64(1200, dec.Z), zero, EndOfPeriod, dec.Centi, dec.HalfEven)), "-100"},
+		{"PMT ceiling", must(PMT(dec.Deci.MustParse("0.1"), 10, dec.FromInt64(10000, dec.Z), zero, EndOfPeriod, dec.Centi, dec.Ceiling)), "-1627.45"},
+		{"PMT floor", must(PMT(dec.Deci.MustParse("0.1"), 10, dec.FromInt64(10000, dec.Z), zero, EndOfPeriod, dec.Centi, dec.Floor)), "-1627.46"},
+		{"FV due", must(FV(dec.Milli.MustParse("0.005"), 10, dec.FromInt64(-200, dec.Z), dec.FromInt64(-500, dec.Z), BeginningOfPeriod, dec.Centi, dec.HalfEven)), "2581.4"},
+		{"PV", must(PV(dec.Centi.MustParse("0.05"), 20, dec.FromInt64(-500, dec.Z), zero, EndOfPeriod, dec.Centi, dec.HalfEven)), "6231.11"},
+		{"PV of FV", must(PV(dec.Centi.MustParse("0.1"), 2, zero, dec.FromInt64(121, dec.Z), EndOfPeriod, dec.Centi, dec.HalfEven)), "-100"},
+		{"NPV", must(NPV(dec.Deci.MustParse("0.1"), parse("-10000", "3000", "4200", "6800"), dec.Centi, dec.HalfEven)), "1188.44"},
+	} {
+		if got := tc.got.String(); got != tc.expected {
+			t.Fatalf("invalid %s, expected %s, got %s", tc.name, tc.expected, got)
+		}
+	}
+}
+
+func Test_IRR(t *testing.T) {
+	for _, tc := range []struct {
+		values   []dec.Decimal
+		p        dec.Precision
+		m        dec.RoundingMode
+		expected string
+	}{
+		{parse("-70000", "12000", "15000", "18000", "21000", "26000"), dec.Micro, dec.HalfEven, "0.086631"},
+		{parse("-70000", "12000", "15000", "18000", "21000", "26000"), 20, dec.HalfEven, "0.08663094803653161429"},
+		{parse("-100", "39", "59", "55", "20"), dec.Nano, dec.ToZero, "0.280948421"},
+		{parse("-100", "110"), dec.Centi, dec.HalfEven, "0.1"},
+		{parse("-100", "0", "0", "0", "0", "1"), dec.Micro, dec.HalfEven, "-0.601893"},
+	} {
+		if got := must(IRR(tc.values, tc.p, tc.m)).String(); got != tc.expected {
+			t.Fatalf("invalid IRR of %v, expected %s, got %s", tc.values, tc.expected, got)
+		}
+	}
+	// a bad guess falls back to the bisection.
+	guess := dec.FromInt64(-100, dec.Z)
+	if got, expected := must(Solver{Guess: &guess, MaxIterations: 3}.IRR(parse("-100", "39", "59", "55", "20"), dec.Nano, dec.HalfEven)).String(), "0.280948421"; got != expected {
+		t.Fatalf("invalid IRR with a bad guess, expected %s, got %s", expected, got)
+	}
+}
+
+func Test_XIRR(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 12, 0, 0, 0, time.UTC)
+	}
+	flows := []CashFlow{
+		{date(2008, time.January, 1), dec.FromInt64(-10000, dec.Z)},
+		{date(2008, time.March, 1), dec.FromInt64(2750, dec.Z)},
+		{date(2008, time.October, 30), dec.FromInt64(4250, dec.Z)},
+		{date(2009, time.February, 15), dec.FromInt64(3250, dec.Z)},
+		{date(2009, time.April, 1), dec.FromInt64(2750, dec.Z)},
+	}
+	if got, expected := must(XIRR(context.Background(), flows, dec.Nano, dec.HalfEven)).String(), "0.373362534"; got != expected {
+		t.Fatalf("invalid XIRR, expected %s, got %s", expected, got)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := XIRR(ctx, flows, dec.Nano, dec.HalfEven); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func Test_Errors(t *testing.T) {
+	zero := dec.Zero(dec.Z)
+	if _, err := PMT(dec.One(dec.Z).Neg(), 10, zero, zero, EndOfPeriod, dec.Centi, dec.HalfEven); !errors.Is(err, ErrInvalidRate) {
+		t.Fatalf("expected ErrInvalidRate, got %v", err)
+	}
+	if _, err := FV(zero, 0, zero, zero, EndOfPeriod, dec.Centi, dec.HalfEven); !errors.Is(err, ErrInvalidPeriods) {
+		t.Fatalf("expected ErrInvalidPeriods, got %v", err)
+	}
+	if _, err := IRR(parse("100", "200"), dec.Centi, dec.HalfEven); !errors.Is(err, ErrNoSignChange) {
+		t.Fatalf("expected ErrNoSignChange, got %v", err)
+	}
+	// 1 - x + x^2 has no real roots.
+	if _, err := IRR(parse("1", "-1", "1"), dec.Centi, dec.HalfEven); !errors.Is(err, ErrNoConvergence) {
+		t.Fatalf("expected ErrNoConvergence, got %v", err)
+	}
+}
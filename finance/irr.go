@@ -0,0 +1,227 @@
+package finance
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	dec "github.com/pr0n1x/decimal-go"
+)
+
+// CashFlow is an amount paid or received at the date.
+type CashFlow struct {
+	Date   time.Time
+	Amount dec.Decimal
+}
+
+// Solver finds the rates of return, the zero value uses the defaults.
+// It runs Newton's method from the Guess and falls back to bisection
+// of the first bracket around a root found among the rates from -0.99 to 1000.
+// The rate is converged when the Newton's step or the bracket is less than a hundredth of the unit of the precision.
+type Solver struct {
+	Guess         *dec.Decimal // the initial rate, 0.1 if nil.
+	MaxIterations int          // the limit of Newton's iterations, 100 if not positive.
+}
+
+// guardDigits is a number of extra digits of the rate and the function values the solver works with.
+const guardDigits = 8
+
+var (
+	defaultGuess = dec.Deci.MustParse("0.1")
+	minusOne     = dec.One(dec.Z).Neg()
+	two          = dec.FromInt64(2, dec.Z)
+	daysInYear   = dec.FromInt64(365, dec.Z)
+	brackets     = []dec.Decimal{
+		dec.Centi.MustParse("-0.99"), dec.Centi.MustParse("-0.9"), dec.Centi.MustParse("-0.5"),
+		dec.Centi.MustParse("-0.2"), dec.Zero(dec.Centi), dec.Centi.MustParse("0.2"), dec.Centi.MustParse("0.5"),
+		dec.FromInt64(1, dec.Centi), dec.FromInt64(3, dec.Centi), dec.FromInt64(10, dec.Centi),
+		dec.FromInt64(100, dec.Centi), dec.FromInt64(1000, dec.Centi),
+	}
+)
+
+// rateFunc returns the value and the derivative of a function of the rate rounded to the working precision.
+type rateFunc func(rate dec.Decimal) (v, dv dec.Decimal, err error)
+
+// IRR returns the internal rate of return per period of the periodic cash flows, see Solver.IRR.
+func IRR(values []dec.Decimal, p dec.Precision, m dec.RoundingMode) (dec.Decimal, error) {
+	return Solver{}.IRR(values, p, m)
+}
+
+// XIRR returns the annual internal rate of return of the dated cash flows, see Solver.XIRR.
+func XIRR(ctx context.Context, flows []CashFlow, p dec.Precision, m dec.RoundingMode) (dec.Decimal, error) {
+	return Solver{}.XIRR(ctx, flows, p, m)
+}
+
+// IRR returns the rate per period which makes the net present value of the periodic cash flows zero,
+// the first value is not discounted. The rate is rounded to p using the mode m.
+func (s Solver) IRR(values []dec.Decimal, p dec.Precision, m dec.RoundingMode) (dec.Decimal, error) {
+	if err := checkSigns(values); err != nil {
+		return dec.Decimal{}, err
+	}
+	wp := workingPrecision(values, p)
+	coefficients := make([]*big.Rat, len(values))
+	for i, v := range values {
+		coefficients[i] = toRat(v)
+	}
+	// npv(rate) = sum(values[i] * x^i) where x = 1 / (1+rate), npv'(rate) = -x^2 * sum(i * values[i] * x^(i-1)).
+	npv := func(rate dec.Decimal) (dec.Decimal, dec.Decimal, error) {
+		x := new(big.Rat).Add(ratOne, toRat(rate))
+		x.Inv(x)
+		v, dv := new(big.Rat), new(big.Rat)
+		for i := len(coefficients) - 1; i >= 0; i-- {
+			dv.Mul(dv, x).Add(dv, v)
+			v.Mul(v, x).Add(v, coefficients[i])
+		}
+		dv.Mul(dv, x).Mul(dv, x).Neg(dv)
+		return roundRat(v, wp, dec.HalfEven), roundRat(dv, wp, dec.HalfEven), nil
+	}
+	return s.solve(npv, wp, p, m)
+}
+
+// XIRR returns the annual rate which makes the net present value of the dated cash flows zero,
+// the amounts are discounted by (1+rate)^(days/365) where days are passed since the date of the first flow.
+// The rate is rounded to p using the mode m.
+func (s Solver) XIRR(ctx context.Context, flows []CashFlow, p dec.Precision, m dec.RoundingMode) (dec.Decimal, error) {
+	amounts := make([]dec.Decimal, len(flows))
+	for i, flow := range flows {
+		amounts[i] = flow.Amount
+	}
+	if err := checkSigns(amounts); err != nil {
+		return dec.Decimal{}, err
+	}
+	wp := workingPrecision(amounts, p)
+	// the terms are computed with a few more digits to keep their sum exact within the working precision.
+	gp := wp + 4
+	days := make([]dec.Decimal, len(flows))
+	for i, flow := range flows {
+		days[i] = dec.FromInt64(daysBetween(flows[0].Date, flow.Date), dec.Z)
+	}
+	// npv(rate) = sum(amount * e^(-days/365 * ln(1+rate))), npv'(rate) = -sum(days * term) / (365 * (1+rate)).
+	npv := func(rate dec.Decimal) (dec.Decimal, dec.Decimal, error) {
+		factor := dec.One(dec.Z).Add(rate)
+		ln, err := factor.Ln(ctx, gp, dec.HalfEven)
+		if err != nil {
+			return dec.Decimal{}, dec.Decimal{}, err
+		}
+		v, dv := dec.Zero(gp), dec.Zero(gp)
+		for i, flow := range flows {
+			if days[i].Sign() == 0 {
+				v = v.Add(flow.Amount)
+				continue
+			}
+			exponent := ln.MulRound(days[i].Neg(), gp, dec.HalfEven).QuoRound(daysInYear, gp, dec.HalfEven)
+			discount, err := exponent.Exp(ctx, gp, dec.HalfEven)
+			if err != nil {
+				return dec.Decimal{}, dec.Decimal{}, err
+			}
+			term := flow.Amount.MulRound(discount, gp, dec.HalfEven)
+			v = v.Add(term)
+			dv = dv.Sub(term.MulRound(days[i], gp, dec.HalfEven))
+		}
+		dv = dv.QuoRound(daysInYear.MulRound(factor, factor.Precision(), dec.HalfEven), wp, dec.HalfEven)
+		return v.Round(wp, dec.HalfEven), dv, nil
+	}
+	return s.solve(npv, wp, p, m)
+}
+
+// solve finds a root of f rounded to p using the mode m.
+func (s Solver) solve(f rateFunc, wp, p dec.Precision, m dec.RoundingMode) (dec.Decimal, error) {
+	guess, iterations := defaultGuess, s.MaxIterations
+	if s.Guess != nil {
+		guess = *s.Guess
+	}
+	if iterations <= 0 {
+		iterations = 100
+	}
+	tolerance := dec.Unit(p + 2)
+	rate := guess.Round(wp, dec.HalfEven).Rescale(wp)
+	for i := 0; i < iterations && rate.Cmp(minusOne) > 0; i++ {
+		v, dv, err := f(rate)
+		if err != nil {
+			return dec.Decimal{}, err
+		}
+		if v.Sign() == 0 {
+			return rate.Round(p, m).Rescale(p), nil
+		}
+		if dv.Sign() == 0 {
+			break
+		}
+		step := v.QuoRound(dv, wp, dec.HalfEven)
+		rate = rate.Sub(step)
+		if step.Abs().Cmp(tolerance) < 0 && rate.Cmp(minusOne) > 0 {
+			return rate.Round(p, m).Rescale(p), nil
+		}
+	}
+	return bisect(f, wp, p, m)
+}
+
+// bisect halves the first bracket around a root until it's less than a hundredth of the unit of p.
+func bisect(f rateFunc, wp, p dec.Precision, m dec.RoundingMode) (dec.Decimal, error) {
+	var lo, hi dec.Decimal
+	var loSign int
+	found := false
+	for _, rate := range brackets {
+		v, _, err := f(rate)
+		if err != nil {
+			return dec.Decimal{}, err
+		}
+		if v.Sign() == 0 {
+			return rate.Round(p, m).Rescale(p), nil
+		}
+		if found = loSign != 0 && loSign != v.Sign(); found {
+			hi = rate
+			break
+		}
+		lo, loSign = rate, v.Sign()
+	}
+	if !found {
+		return dec.Decimal{}, ErrNoConvergence
+	}
+	tolerance := dec.Unit(p + 2)
+	for hi.Sub(lo).Cmp(tolerance) >= 0 {
+		mid := lo.Add(hi).QuoRound(two, wp, dec.HalfEven)
+		v, _, err := f(mid)
+		if err != nil {
+			return dec.Decimal{}, err
+		}
+		switch v.Sign() {
+		case 0:
+			return mid.Round(p, m).Rescale(p), nil
+		case loSign:
+			lo = mid
+		default:
+			hi = mid
+		}
+	}
+	return lo.Add(hi).QuoRound(two, wp, dec.HalfEven).Round(p, m).Rescale(p), nil
+}
+
+// checkSigns returns ErrNoSignChange unless the values have both positive and negative ones.
+func checkSigns(values []dec.Decimal) error {
+	var positive, negative bool
+	for _, v := range values {
+		positive = positive || v.Sign() > 0
+		negative = negative || v.Sign() < 0
+	}
+	if !positive || !negative {
+		return ErrNoSignChange
+	}
+	return nil
+}
+
+// workingPrecision returns the precision of the rate and the function values
+// which is enough to find the rate accurate to p regardless of the precision of the amounts.
+func workingPrecision(values []dec.Decimal, p dec.Precision) dec.Precision {
+	wp := p
+	for _, v := range values {
+		wp = max(wp, v.Precision())
+	}
+	return wp + guardDigits
+}
+
+// daysBetween returns the number of calendar days from the date of the time from to the date of the time to.
+func daysBetween(from, to time.Time) int64 {
+	y1, m1, d1 := from.Date()
+	y2, m2, d2 := to.Date()
+	return (time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC).Unix() - time.Date(y1, m1, d1, 0, 0, 0, 0, time.UTC).Unix()) / 86400
+}
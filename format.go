@@ -0,0 +1,260 @@
+package dec
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Format implements fmt.Formatter for the verbs f, F, e, E, g, G, s, v and q.
+// The width, the precision and the flags '+', ' ', '-' and '0' work as for float64,
+// the digits are rounded using the HalfEven mode without a conversion to float.
+// Unlike float64 the default precision of %f is the precision of d and the default one of %e
+// is the number of significant digits, so the value is printed exactly.
+// %v and %s print the same as String, the precision rounds the fractional digits as for %f.
+func (d Decimal) Format(s fmt.State, verb rune) {
+	prec, hasPrec := s.Precision()
+	if !hasPrec {
+		prec = -1
+	}
+	digs, dp := d.digits()
+	var num string
+	switch verb {
+	case 'v', 's':
+		if hasPrec {
+			num = formatF(digs, dp, prec)
+		} else {
+			num = formatF(digs, dp, max(len(digs)-dp, 0))
+		}
+	case 'f', 'F':
+		if !hasPrec {
+			prec = int(d.Precision())
+		}
+		num = formatF(digs, dp, prec)
+	case 'e', 'E':
+		num = formatE(digs, dp, prec, byte(verb))
+	case 'g', 'G':
+		num = formatG(digs, dp, prec, byte(verb)+'e'-'g')
+	case 'q':
+		pad(s, strconv.Quote(d.String()), "")
+		return
+	default:
+		_, _ = fmt.Fprintf(s, "%%!%c(dec.Decimal=%s)", verb, d.String())
+		return
+	}
+	sign := ""
+	switch {
+	case d.Sign() < 0:
+		sign = "-"
+	case s.Flag('+'):
+		sign = "+"
+	case s.Flag(' '):
+		sign = " "
+	}
+	pad(s, num, sign)
+}
+
+// pad writes the sign and the number padded to the width of the state.
+func pad(s fmt.State, num, sign string) {
+	width, ok := s.Width()
+	padding := width - len(sign) - len(num)
+	if !ok || padding <= 0 {
+		_, _ = s.Write([]byte(sign + num))
+		return
+	}
+	switch {
+	case s.Flag('-'):
+		_, _ = s.Write([]byte(sign + num + strings.Repeat(" ", padding)))
+	case s.Flag('0'):
+		_, _ = s.Write([]byte(sign + strings.Repeat("0", padding) + num))
+	default:
+		_, _ = s.Write([]byte(strings.Repeat(" ", padding) + sign + num))
+	}
+}
+
+// digits returns the significant digits of |d| without the trailing zeros
+// and the position of the decimal point: |d| = 0.digits * 10^dp. The zero has no digits.
+func (d Decimal) digits() ([]byte, int) {
+	if d.Sign() == 0 {
+		return nil, 0
+	}
+	units := d.Units()
+	digs := []byte(units.Abs(units).String())
+	dp := len(digs) - int(d.Precision())
+	for digs[len(digs)-1] == '0' {
+		digs = digs[:len(digs)-1]
+	}
+	return digs, dp
+}
+
+// roundDigits rounds the digits to n digits using the HalfEven mode and trims the trailing zeros.
+func roundDigits(digs []byte, dp, n int) ([]byte, int) {
+	if n >= len(digs) {
+		return digs, dp
+	}
+	if n < 0 {
+		return nil, 0
+	}
+	up := false
+	switch {
+	case digs[n] > '5':
+		up = true
+	case digs[n] == '5':
+		// the digits have no trailing zeros, so it's a tie only if the five is the last digit.
+		up = n+1 < len(digs) || n > 0 && (digs[n-1]-'0')%2 == 1
+	}
+	rounded := append([]byte(nil), digs[:n]...)
+	if up {
+		i := n - 1
+		for ; i >= 0 && rounded[i] == '9'; i-- {
+		}
+		if i < 0 {
+			return []byte{'1'}, dp + 1
+		}
+		rounded[i]++
+		rounded = rounded[:i+1]
+	}
+	for len(rounded) > 0 && rounded[len(rounded)-1] == '0' {
+		rounded = rounded[:len(rounded)-1]
+	}
+	if len(rounded) == 0 {
+		return nil, 0
+	}
+	return rounded, dp
+}
+
+// formatF formats the digits as %f with prec fractional digits.
+func formatF(digs []byte, dp, prec int) string {
+	digs, dp = roundDigits(digs, dp, dp+prec)
+	digit := func(i int) byte {
+		if i >= 0 && i < len(digs) {
+			return digs[i]
+		}
+		return '0'
+	}
+	var b strings.Builder
+	if dp > 0 {
+		for i := 0; i < dp; i++ {
+			b.WriteByte(digit(i))
+		}
+	} else {
+		b.WriteByte('0')
+	}
+	if prec > 0 {
+		b.WriteByte('.')
+		for i := 0; i < prec; i++ {
+			b.WriteByte(digit(dp + i))
+		}
+	}
+	return b.String()
+}
+
+// formatE formats the digits as %e with prec digits after the point, all the significant digits if prec < 0.
+func formatE(digs []byte, dp, prec int, e byte) string {
+	if prec < 0 {
+		prec = max(len(digs)-1, 0)
+	}
+	digs, dp = roundDigits(digs, dp, prec+1)
+	digit := func(i int) byte {
+		if i < len(digs) {
+			return digs[i]
+		}
+		return '0'
+	}
+	var b strings.Builder
+	b.WriteByte(digit(0))
+	if prec > 0 {
+		b.WriteByte('.')
+		for i := 1; i <= prec; i++ {
+			b.WriteByte(digit(i))
+		}
+	}
+	exp := 0
+	if len(digs) > 0 {
+		exp = dp - 1
+	}
+	b.WriteByte(e)
+	if exp < 0 {
+		b.WriteByte('-')
+		exp = -exp
+	} else {
+		b.WriteByte('+')
+	}
+	if exp < 10 {
+		b.WriteByte('0')
+	}
+	b.WriteString(strconv.Itoa(exp))
+	return b.String()
+}
+
+// formatG formats the digits as %g the same way as strconv.FormatFloat does.
+func formatG(digs []byte, dp, prec int, e byte) string {
+	shortest := prec < 0
+	if shortest {
+		prec = len(digs)
+	} else {
+		if prec == 0 {
+			prec = 1
+		}
+		digs, dp = roundDigits(digs, dp, prec)
+	}
+	nd := len(digs)
+	if nd == 0 {
+		// zero is formatted as the single digit "0".
+		nd, dp = 1, 1
+		digs = []byte{'0'}
+	}
+	eprec := prec
+	if eprec > nd && nd >= dp {
+		eprec = nd
+	}
+	// %e is used if the exponent from the conversion is less than -4 or greater than or equal to the precision,
+	// the precision 6 is used for this decision if the shortest representation is requested.
+	if shortest {
+		eprec = 6
+	}
+	if exp := dp - 1; exp < -4 || exp >= eprec {
+		if prec > nd {
+			prec = nd
+		}
+		if digs[0] == '0' {
+			digs = nil
+		}
+		return formatE(digs, dp, prec-1, e)
+	}
+	if prec > dp {
+		prec = nd
+	}
+	frac := max(prec-dp, 0)
+	if digs[0] == '0' {
+		digs, dp = nil, 0
+	}
+	return formatF(digs, dp, frac)
+}
+
+// Scan implements fmt.Scanner for the verbs f, F, e, E, g, G, s and v, the exponent notation is accepted,
+// the precision of d is kept if the scanned value has less fractional digits.
+func (d *Decimal) Scan(state fmt.ScanState, verb rune) error {
+	switch verb {
+	case 'f', 'F', 'e', 'E', 'g', 'G', 's', 'v':
+	default:
+		return ErrInvalidDecimalString.Explainf("unsupported scan verb %%%c", verb)
+	}
+	state.SkipSpace()
+	// the sign is accepted at the start of the number and of the exponent.
+	signed := true
+	token, err := state.Token(false, func(r rune) bool {
+		ok := r >= '0' && r <= '9' || r == '.' || r == 'e' || r == 'E' || signed && (r == '-' || r == '+')
+		signed = r == 'e' || r == 'E'
+		return ok
+	})
+	if err != nil {
+		return err
+	}
+	scanned, err := ParseSci(string(token), d.Precision(), false)
+	if err != nil {
+		return err
+	}
+	*d = scanned
+	return nil
+}
@@ -0,0 +1,115 @@
+package dec
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+func Test_Format(t *testing.T) {
+	d := Milli.MustParse("1234.5")
+	neg := Centi.MustParse("2.675").Neg()
+	small := MustParse("0.000123", Micro, true)
+	for _, tc := range []struct {
+		format   string
+		d        Decimal
+		expected string
+	}{
+		{"%v", d, "1234.5"},
+		{"%s", d, "1234.5"},
+		{"%+v", d, "+1234.5"},
+		{"% v", d, " 1234.5"},
+		{"%10v|", d, "    1234.5|"},
+		{"%-10v|", d, "1234.5    |"},
+		{"%010v", neg, "-000002.67"},
+		{"%.2v", neg, "-2.67"},
+		{"%q", d, `"1234.5"`},
+		{"%8q", d, `"1234.5"`},
+		{"%f", d, "1234.500"},
+		{"%F", d, "1234.500"},
+		{"%.0f", d, "1234"},
+		{"%.0f", Deci.MustParse("1235.5"), "1236"},
+		{"%.2f", neg, "-2.67"},
+		{"%.2f", Milli.MustParse("2.665"), "2.66"},
+		{"%.2f", MustParse("2.66501", 5, true), "2.67"},
+		{"%.1f", Centi.MustParse("9.96"), "10.0"},
+		{"%.3f", small, "0.000"},
+		{"%+.3f", small.Neg(), "-0.000"},
+		{"%08.2f", neg, "-0002.67"},
+		{"%e", d, "1.2345e+03"},
+		{"%.2e", d, "1.23e+03"},
+		{"%E", small, "1.23E-04"},
+		{"%.0e", Centi.MustParse("9.5"), "1e+01"},
+		{"%e", Zero(Centi), "0e+00"},
+		{"%g", d, "1234.5"},
+		{"%g", MustParse("12345678", Z, true), "1.2345678e+07"},
+		{"%g", small, "0.000123"},
+		{"%g", MustParse("0.0000123", 7, true), "1.23e-05"},
+		{"%.3g", d, "1.23e+03"},
+		{"%.5G", MustParse("0.00001234567", 11, true), "1.2346E-05"},
+		{"%g", Zero(Nano), "0"},
+		{"%f", Decimal{}, "0"},
+		{"%d", d, "%!d(dec.Decimal=1234.5)"},
+	} {
+		if got := fmt.Sprintf(tc.format, tc.d); got != tc.expected {
+			t.Fatalf("invalid %q of %s, expected %q, got %q", tc.format, tc.d, tc.expected, got)
+		}
+	}
+}
+
+// Test_FormatFloat compares the formatting with float64 on the values which are exact in binary.
+func Test_FormatFloat(t *testing.T) {
+	values := []string{"0", "1", "0.5", "2.5", "-3.5", "0.125", "-0.0625", "1234567.75", "0.00048828125", "1e21"}
+	formats := []string{"%.0f", "%.1f", "%.2f", "%+.3f", "% .1f", "%10.2f", "%-10.1f|", "%010.1f",
+		"%.0e", "%.2e", "%+.3E", "%12.1e", "%g", "%.1g", "%.3g", "%.10g", "%G", "%+g", "%012g"}
+	for _, v := range values {
+		f := 0.0
+		if _, err := fmt.Sscan(v, &f); err != nil {
+			t.Fatal(err)
+		}
+		d := MustParse(strconv.FormatFloat(f, 'f', -1, 64), Z, false)
+		for _, format := range formats {
+			if got, expected := fmt.Sprintf(format, d), fmt.Sprintf(format, f); got != expected {
+				t.Fatalf("invalid %q of %s, expected %q, got %q", format, v, expected, got)
+			}
+		}
+	}
+}
+
+func Test_Scan(t *testing.T) {
+	var a, b, c Decimal
+	n, err := fmt.Sscan("1.25 -0.5 +7", &a, &b, &c)
+	if err != nil || n != 3 {
+		t.Fatalf("scan failed: %d %v", n, err)
+	}
+	if a.String() != "1.25" || b.String() != "-0.5" || c.String() != "7" {
+		t.Fatalf("invalid scanned values %s %s %s", a, b, c)
+	}
+	if a.Precision() != Centi || c.Precision() != Z {
+		t.Fatalf("invalid scanned precisions %d %d", a.Precision(), c.Precision())
+	}
+	x := Milli.Zero()
+	if _, err := fmt.Sscanf("price=12.5;", "price=%f;", &x); err != nil {
+		t.Fatal(err)
+	}
+	if x.String() != "12.5" || x.Precision() != Milli {
+		t.Fatalf("invalid scanned value %s with precision %d", x, x.Precision())
+	}
+	var e1, e2 Decimal
+	if n, err := fmt.Sscan("1.5e-7 -2E+3", &e1, &e2); err != nil || n != 2 {
+		t.Fatalf("scan failed: %d %v", n, err)
+	}
+	if e1.String() != "0.00000015" || e2.String() != "-2000" {
+		t.Fatalf("invalid scanned values %s %s", e1, e2)
+	}
+	if _, err := fmt.Sscanf("2.5E+3", "%e", &x); err != nil || x.String() != "2500" || x.Precision() != Milli {
+		t.Fatalf("invalid scanned value %s, %v", x, err)
+	}
+	if _, err := fmt.Sscan("1e", &x); !errors.Is(err, ErrInvalidDecimalString) {
+		t.Fatalf("expected ErrInvalidDecimalString, got %v", err)
+	}
+	if _, err := fmt.Sscan("abc", &x); err == nil {
+		t.Fatal("expected a scan error")
+	}
+}
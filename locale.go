@@ -0,0 +1,242 @@
+package dec
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/pr0n1x/go-liners/werr"
+)
+
+var (
+	ErrUnknownLocale   = werr.New("unknown locale")
+	ErrAmbiguousNumber = werr.New("ambiguous number, the locale is required")
+)
+
+// NumberFormat is a decimal separator and a grouping separator of the integer digits.
+// The groups have three digits, except the Indian grouping where the groups before the last one have two: "12,34,567".
+// A zero separator isn't used or isn't found in the parsed number.
+type NumberFormat struct {
+	Decimal rune
+	Group   rune // ' ' stands for the no-break spaces too, '\'' for the right single quotation mark.
+	Indian  bool
+}
+
+func (f NumberFormat) String() string {
+	s := fmt.Sprintf("decimal %q, group %q", f.Decimal, f.Group)
+	if f.Indian {
+		s += ", indian grouping"
+	}
+	return s
+}
+
+var (
+	pointComma      = NumberFormat{Decimal: '.', Group: ','}
+	pointCommaIndia = NumberFormat{Decimal: '.', Group: ',', Indian: true}
+	pointQuote      = NumberFormat{Decimal: '.', Group: '\''}
+	commaPoint      = NumberFormat{Decimal: ',', Group: '.'}
+	commaSpace      = NumberFormat{Decimal: ',', Group: ' '}
+)
+
+// localeFormats maps the languages and the language-region pairs to their formats,
+// a region is looked up first and then its language.
+var localeFormats = map[string]NumberFormat{
+	"en": pointComma, "ja": pointComma, "zh": pointComma, "ko": pointComma, "he": pointComma,
+	"th": pointComma, "ms": pointComma, "es-mx": pointComma, "es-us": pointComma,
+	"en-in": pointCommaIndia, "hi": pointCommaIndia, "bn": pointCommaIndia, "mr": pointCommaIndia,
+	"ta": pointCommaIndia, "te": pointCommaIndia, "gu": pointCommaIndia,
+	"de-ch": pointQuote, "fr-ch": pointQuote, "it-ch": pointQuote, "de-li": pointQuote, "rm": pointQuote,
+	"de": commaPoint, "es": commaPoint, "it": commaPoint, "nl": commaPoint, "pt": commaPoint,
+	"id": commaPoint, "tr": commaPoint, "da": commaPoint, "el": commaPoint, "ro": commaPoint,
+	"fr": commaSpace, "ru": commaSpace, "uk": commaSpace, "pl": commaSpace, "cs": commaSpace,
+	"sk": commaSpace, "sv": commaSpace, "fi": commaSpace, "nb": commaSpace, "no": commaSpace,
+	"hu": commaSpace, "bg": commaSpace, "pt-pt": commaSpace, "de-at": commaSpace,
+}
+
+// LocaleFormat returns the number format of the locale: "de", "de-CH", "en_IN.UTF-8".
+func LocaleFormat(locale string) (NumberFormat, error) {
+	tag := strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	if i := strings.IndexAny(tag, ".@"); i >= 0 {
+		tag = tag[:i]
+	}
+	if f, ok := localeFormats[tag]; ok {
+		return f, nil
+	}
+	language, _, _ := strings.Cut(tag, "-")
+	if f, ok := localeFormats[language]; ok {
+		return f, nil
+	}
+	return NumberFormat{}, ErrUnknownLocale.Explainf("%q", locale)
+}
+
+// ParseLocale parses the number with the separators of the locale and returns the format of the number:
+// "1 234,56" (fr), "1.234,56" (de), "1,234.56" (en), "12,34,567.89" (en-IN), "1'234.56" (de-CH).
+// If the locale is empty the format is detected, ErrAmbiguousNumber is returned when the only separator
+// could be both the decimal and the grouping one: "1,234".
+// The precision is extended to keep all the fractional digits as Parse does.
+func ParseLocale(s, locale string, precision Precision) (Decimal, NumberFormat, error) {
+	n, err := splitNumber(s)
+	if err != nil {
+		return Decimal{}, NumberFormat{}, err
+	}
+	var f NumberFormat
+	if locale != "" {
+		if f, err = LocaleFormat(locale); err != nil {
+			return Decimal{}, NumberFormat{}, err
+		}
+	} else if f, err = n.detect(); err != nil {
+		return Decimal{}, NumberFormat{}, err
+	}
+	number, err := n.join(f)
+	if err != nil {
+		return Decimal{}, NumberFormat{}, err
+	}
+	d, err := Parse(number, precision, false)
+	if err != nil {
+		return Decimal{}, NumberFormat{}, err
+	}
+	return d, f, nil
+}
+
+// groupedNumber is a number split into the runs of the digits by the separators.
+type groupedNumber struct {
+	input   string
+	sign    string
+	digits  []string
+	seps    []rune
+	offsets []int // the offsets of the separators in the input.
+}
+
+// splitNumber splits the number by the single separators between the digits.
+func splitNumber(s string) (groupedNumber, error) {
+	n := groupedNumber{input: s}
+	body := strings.TrimLeftFunc(s, unicode.IsSpace)
+	start := len(s) - len(body)
+	body = strings.TrimRightFunc(body, unicode.IsSpace)
+	if body == "" {
+		return n, &ParseError{Input: s, Offset: start, Reason: "empty number"}
+	}
+	if body[0] == '-' || body[0] == '+' {
+		n.sign, body, start = body[:1], body[1:], start+1
+	}
+	var group strings.Builder
+	for i, r := range body {
+		switch {
+		case r >= '0' && r <= '9':
+			group.WriteRune(r)
+			continue
+		case !isSeparator(r):
+			return n, &ParseError{Input: s, Offset: start + i, Reason: fmt.Sprintf("unexpected character %q", r)}
+		case group.Len() == 0:
+			return n, &ParseError{Input: s, Offset: start + i, Reason: "separator is not between digits"}
+		}
+		n.digits = append(n.digits, group.String())
+		n.seps = append(n.seps, normalizeSeparator(r))
+		n.offsets = append(n.offsets, start+i)
+		group.Reset()
+	}
+	if group.Len() == 0 {
+		if len(n.seps) == 0 {
+			return n, &ParseError{Input: s, Offset: start, Reason: "no digits"}
+		}
+		return n, &ParseError{Input: s, Offset: n.offsets[len(n.offsets)-1], Reason: "separator is not between digits"}
+	}
+	n.digits = append(n.digits, group.String())
+	return n, nil
+}
+
+func isSeparator(r rune) bool {
+	return strings.ContainsRune(".,' \u00a0\u202f\u2019", r)
+}
+
+// normalizeSeparator maps the no-break spaces to the space and the right single quotation mark to the apostrophe.
+func normalizeSeparator(r rune) rune {
+	switch r {
+	case '\u00a0', '\u202f':
+		return ' '
+	case '\u2019':
+		return '\''
+	}
+	return r
+}
+
+// detect detects the format of the number: the last one of two different separators is the decimal one,
+// the single separator is the decimal one unless it's repeated or could be a grouping one.
+func (n groupedNumber) detect() (NumberFormat, error) {
+	var kinds []rune
+	for i, sep := range n.seps {
+		if !strings.ContainsRune(string(kinds), sep) {
+			kinds = append(kinds, sep)
+		}
+		if len(kinds) > 2 {
+			return NumberFormat{}, &ParseError{Input: n.input, Offset: n.offsets[i], Reason: "too many kinds of separators"}
+		}
+	}
+	var f NumberFormat
+	switch {
+	case len(kinds) == 0:
+		return f, nil
+	case len(kinds) == 2:
+		f.Decimal, f.Group = n.seps[len(n.seps)-1], kinds[0]
+		if f.Group == f.Decimal {
+			f.Group = kinds[1]
+		}
+	case kinds[0] == ' ' || kinds[0] == '\'' || len(n.seps) > 1:
+		f.Group = kinds[0]
+	case grouped(n.digits, false) || grouped(n.digits, true):
+		return NumberFormat{}, ErrAmbiguousNumber.Explainf("%q", n.input)
+	default:
+		f.Decimal = kinds[0]
+	}
+	if f.Decimal == ' ' || f.Decimal == '\'' {
+		return NumberFormat{}, &ParseError{Input: n.input, Offset: n.offsets[len(n.offsets)-1], Reason: "invalid decimal separator"}
+	}
+	intDigits := n.digits
+	if f.Decimal != 0 {
+		intDigits = n.digits[:len(n.digits)-1]
+	}
+	f.Indian = !grouped(intDigits, false) && grouped(intDigits, true)
+	return f, nil
+}
+
+// grouped reports whether the integer digits are grouped by three or by the Indian grouping.
+func grouped(digits []string, indian bool) bool {
+	size := 3
+	if indian {
+		size = 2
+	}
+	for i, group := range digits {
+		switch {
+		case i == len(digits)-1 && len(digits) > 1:
+			if len(group) != 3 {
+				return false
+			}
+		case i == 0:
+			if len(group) > size || group[0] == '0' {
+				return false
+			}
+		case len(group) != size:
+			return false
+		}
+	}
+	return true
+}
+
+// join returns the number without the grouping separators and with the point as the decimal separator.
+func (n groupedNumber) join(f NumberFormat) (string, error) {
+	intDigits, frac := n.digits, ""
+	for i, sep := range n.seps {
+		switch {
+		case sep == f.Decimal && i == len(n.seps)-1:
+			intDigits, frac = n.digits[:i+1], "."+n.digits[i+1]
+		case sep == f.Decimal:
+			return "", &ParseError{Input: n.input, Offset: n.offsets[i+1], Reason: "separator after the decimal separator"}
+		case sep != f.Group:
+			return "", &ParseError{Input: n.input, Offset: n.offsets[i], Reason: fmt.Sprintf("unexpected separator %q", sep)}
+		}
+	}
+	if len(intDigits) > 1 && !grouped(intDigits, f.Indian) {
+		return "", &ParseError{Input: n.input, Offset: n.offsets[0], Reason: "invalid digit grouping"}
+	}
+	return n.sign + strings.Join(intDigits, "") + frac, nil
+}
@@ -0,0 +1,95 @@
+package dec
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_ParseLocale(t *testing.T) {
+	for _, tc := range []struct {
+		input, locale string
+		expected      string
+		format        NumberFormat
+	}{
+		{"1 234,56", "", "1234.56", NumberFormat{Decimal: ',', Group: ' '}},
+		{"1 234 567,5", "fr-FR", "1234567.5", commaSpace},
+		{"1.234,56", "", "1234.56", commaPoint},
+		{"1,234.56", "", "1234.56", pointComma},
+		{"-12,34,567.89", "", "-1234567.89", pointCommaIndia},
+		{"12,34,567.89", "en_IN.UTF-8", "1234567.89", pointCommaIndia},
+		{"1'234.56", "", "1234.56", pointQuote},
+		{"1’234.5", "de-CH", "1234.5", pointQuote},
+		{"1.234.567", "", "1234567", NumberFormat{Group: '.'}},
+		{"1 234", "", "1234", NumberFormat{Group: ' '}},
+		{"1,5", "", "1.5", NumberFormat{Decimal: ','}},
+		{"0,125", "", "0.125", NumberFormat{Decimal: ','}},
+		{"1234.567", "", "1234.567", NumberFormat{Decimal: '.'}},
+		{" +42 ", "", "42", NumberFormat{}},
+		{"1,234", "en", "1234", pointComma},
+		{"1,234", "de", "1.234", commaPoint},
+		{"1 234,5", "de-AT", "1234.5", commaSpace},
+		{"1234,5", "pt-BR", "1234.5", commaPoint},
+	} {
+		d, f, err := ParseLocale(tc.input, tc.locale, Centi)
+		if err != nil {
+			t.Fatalf("failed to parse %q in %q: %v", tc.input, tc.locale, err)
+		}
+		if got := d.String(); got != tc.expected || f != tc.format {
+			t.Fatalf("invalid result of %q in %q, expected %s (%s), got %s (%s)",
+				tc.input, tc.locale, tc.expected, tc.format, got, f)
+		}
+		if d.Precision() < Centi {
+			t.Fatalf("precision of %q is lost", tc.input)
+		}
+	}
+}
+
+func Test_ParseLocaleErrors(t *testing.T) {
+	for _, tc := range []struct {
+		input, locale string
+		err           error
+		offset        int
+	}{
+		{"1,234", "", ErrAmbiguousNumber, 0},
+		{"12.345", "", ErrAmbiguousNumber, 0},
+		{"1,23", "", nil, 0},
+		{"1,234", "xx", ErrUnknownLocale, 0},
+		{"", "", ErrInvalidDecimalString, 0},
+		{"1,,234", "", ErrInvalidDecimalString, 2},
+		{"1,234.", "", ErrInvalidDecimalString, 5},
+		{"1,2345.6", "", ErrInvalidDecimalString, 1},
+		{"12,34,567.89", "en", ErrInvalidDecimalString, 2},
+		{"1.234,5.6", "", ErrInvalidDecimalString, 5},
+		{"1,234.5", "de", ErrInvalidDecimalString, 5},
+		{"1 234.5", "de", ErrInvalidDecimalString, 1},
+		{"1'234 5", "", ErrInvalidDecimalString, 5},
+		{"1.2,3 4", "", ErrInvalidDecimalString, 5},
+		{"1e5", "", ErrInvalidDecimalString, 1},
+	} {
+		_, _, err := ParseLocale(tc.input, tc.locale, Centi)
+		if tc.err == nil {
+			if err != nil {
+				t.Fatalf("failed to parse %q: %v", tc.input, err)
+			}
+			continue
+		}
+		if !errors.Is(err, tc.err) {
+			t.Fatalf("expected %v for %q in %q, got %v", tc.err, tc.input, tc.locale, err)
+		}
+		var pe *ParseError
+		if errors.As(err, &pe) && pe.Offset != tc.offset {
+			t.Fatalf("invalid offset of the error %v, expected %d", err, tc.offset)
+		}
+	}
+}
+
+func Test_LocaleFormat(t *testing.T) {
+	for locale, expected := range map[string]NumberFormat{
+		"en": pointComma, "en-US": pointComma, "EN_in": pointCommaIndia, "de_CH.UTF-8": pointQuote,
+		"de-DE": commaPoint, "ru_RU@euro": commaSpace, "fr-CH": pointQuote,
+	} {
+		if f, err := LocaleFormat(locale); err != nil || f != expected {
+			t.Fatalf("invalid format of %q, expected %s, got %s, %v", locale, expected, f, err)
+		}
+	}
+}
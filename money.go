@@ -0,0 +1,189 @@
+package dec
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pr0n1x/go-liners/werr"
+)
+
+var (
+	ErrCurrencyMismatch   = werr.New("currencies mismatch")
+	ErrInvalidMoneyAmount = werr.New("amount has more digits than the currency minor unit")
+)
+
+// Money is an amount of a currency.
+// The constructors round the amount to the minor unit of the currency, the operations on the amounts
+// of different currencies return ErrCurrencyMismatch and the ones on unknown currencies return ErrUnknownCurrency.
+type Money struct {
+	Amount   Decimal
+	Currency Currency
+}
+
+// NewMoney returns the amount of the currency rounded to the minor unit using the HalfEven mode.
+func NewMoney(amount Decimal, currency Currency) (Money, error) {
+	return NewMoneyRound(amount, currency, HalfEven)
+}
+
+// NewMoneyRound returns the amount of the currency rounded to the minor unit using the mode m.
+func NewMoneyRound(amount Decimal, currency Currency, m RoundingMode) (Money, error) {
+	return Money{Amount: amount, Currency: currency}.Round(m)
+}
+
+// ParseMoney parses the amount of the currency code,
+// it returns ErrInvalidMoneyAmount if the amount has non-zero digits beyond the minor unit.
+func ParseMoney(amount, code string) (Money, error) {
+	currency, err := ParseCurrency(code)
+	if err != nil {
+		return Money{}, err
+	}
+	p, _ := currency.Precision()
+	d, err := Parse(amount, p, false)
+	if err != nil {
+		return Money{}, err
+	}
+	if d.Precision() > p {
+		rescaled, rem := d.RescaleRem(p)
+		if rem.Sign() != 0 {
+			return Money{}, ErrInvalidMoneyAmount.Explainf("%s %s", amount, currency)
+		}
+		d = rescaled
+	}
+	return Money{Amount: d, Currency: currency}, nil
+}
+
+// MustParseMoney the same as ParseMoney but panics on error.
+func MustParseMoney(amount, code string) Money {
+	return must(ParseMoney(amount, code))
+}
+
+func (mn Money) String() string {
+	return mn.Amount.String() + " " + string(mn.Currency)
+}
+
+// Round rounds the amount to the minor unit of the currency using the mode m.
+func (mn Money) Round(m RoundingMode) (Money, error) {
+	p, err := mn.Currency.Precision()
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: roundTo(mn.Amount, p, m), Currency: mn.Currency}, nil
+}
+
+func (mn Money) Add(rhs Money) (Money, error) {
+	if err := mn.check(rhs); err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: mn.Amount.Add(rhs.Amount), Currency: mn.Currency}, nil
+}
+
+func (mn Money) Sub(rhs Money) (Money, error) {
+	if err := mn.check(rhs); err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: mn.Amount.Sub(rhs.Amount), Currency: mn.Currency}, nil
+}
+
+func (mn Money) Cmp(rhs Money) (int, error) {
+	if err := mn.check(rhs); err != nil {
+		return 0, err
+	}
+	return mn.Amount.Cmp(rhs.Amount), nil
+}
+
+// Mul returns the amount multiplied by the factor and rounded to the minor unit using the mode m.
+func (mn Money) Mul(factor Decimal, m RoundingMode) (Money, error) {
+	p, err := mn.Currency.Precision()
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: mn.Amount.MulRound(factor, p, m).Rescale(p), Currency: mn.Currency}, nil
+}
+
+// Quo returns the amount divided by the divisor and rounded to the minor unit using the mode m.
+func (mn Money) Quo(divisor Decimal, m RoundingMode) (Money, error) {
+	p, err := mn.Currency.Precision()
+	if err != nil {
+		return Money{}, err
+	}
+	quo, err := mn.Amount.TryQuoRound(divisor, p, m)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: quo.Rescale(p), Currency: mn.Currency}, nil
+}
+
+// Allocate distributes the amount proportionally to the weights in the minor units of the currency
+// or in the units of the amount if it has more digits, see Allocate.
+func (mn Money) Allocate(weights ...Decimal) ([]Money, error) {
+	p, err := mn.Currency.Precision()
+	if err != nil {
+		return nil, err
+	}
+	parts, err := Allocate(mn.Amount.Rescale(max(p, mn.Amount.Precision())), weights...)
+	if err != nil {
+		return nil, err
+	}
+	allocated := make([]Money, len(parts))
+	for i, part := range parts {
+		allocated[i] = Money{Amount: part, Currency: mn.Currency}
+	}
+	return allocated, nil
+}
+
+func (mn Money) Neg() Money {
+	return Money{Amount: mn.Amount.Neg(), Currency: mn.Currency}
+}
+
+func (mn Money) Abs() Money {
+	return Money{Amount: mn.Amount.Abs(), Currency: mn.Currency}
+}
+
+func (mn Money) Sign() int {
+	return mn.Amount.Sign()
+}
+
+func (mn Money) IsZero() bool {
+	return mn.Amount.Sign() == 0
+}
+
+type moneyJSON struct {
+	Amount   string   `json:"amount"`
+	Currency Currency `json:"currency"`
+}
+
+// MarshalJSON encodes the money as {"amount":"1.20","currency":"USD"},
+// the amount has the digits of the minor unit and the extra digits of the amount if any.
+func (mn Money) MarshalJSON() ([]byte, error) {
+	p, err := mn.Currency.Precision()
+	if err != nil {
+		return nil, err
+	}
+	amount := fmt.Sprintf("%.*f", int(max(p, mn.Amount.Precision())), mn.Amount)
+	return json.Marshal(moneyJSON{Amount: amount, Currency: mn.Currency})
+}
+
+// UnmarshalJSON decodes the money from {"amount":"1.23","currency":"USD"} the same way as ParseMoney.
+func (mn *Money) UnmarshalJSON(data []byte) error {
+	var v moneyJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	parsed, err := ParseMoney(v.Amount, string(v.Currency))
+	if err != nil {
+		return err
+	}
+	*mn = parsed
+	return nil
+}
+
+// check returns an error if the currencies of the money differ or aren't known.
+func (mn Money) check(rhs Money) error {
+	if mn.Currency != rhs.Currency {
+		return ErrCurrencyMismatch.Explainf("%s and %s", mn.Currency, rhs.Currency)
+	}
+	if !mn.Currency.Known() {
+		return ErrUnknownCurrency.Explainf("%q", string(mn.Currency))
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package dec
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func Test_CurrencyPrecision(t *testing.T) {
+	for code, expected := range map[string]Precision{"JPY": Z, "usd": Centi, " BHD ": Milli, "CLF": 4} {
+		currency, err := ParseCurrency(code)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := must(currency.Precision()); got != expected {
+			t.Fatalf("invalid precision of %s, expected %d, got %d", code, expected, got)
+		}
+	}
+	if _, err := ParseCurrency("XYZ"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Fatalf("expected ErrUnknownCurrency, got %v", err)
+	}
+	if _, err := Currency("usd").Precision(); !errors.Is(err, ErrUnknownCurrency) {
+		t.Fatalf("expected ErrUnknownCurrency, got %v", err)
+	}
+}
+
+func Test_NewMoney(t *testing.T) {
+	for _, tc := range []struct {
+		amount   Decimal
+		currency Currency
+		m        RoundingMode
+		expected string
+	}{
+		{Milli.MustParse("1.005"), "USD", HalfEven, "1 USD"},
+		{Milli.MustParse("1.005"), "USD", HalfUp, "1.01 USD"},
+		{Centi.MustParse("1234.5"), "JPY", HalfEven, "1234 JPY"},
+		{Deci.MustParse("1.5"), "BHD", HalfEven, "1.5 BHD"},
+	} {
+		mn := must(NewMoneyRound(tc.amount, tc.currency, tc.m))
+		if got := mn.String(); got != tc.expected {
+			t.Fatalf("invalid money of %s %s, expected %s, got %s", tc.amount, tc.currency, tc.expected, got)
+		}
+		if got, expected := mn.Amount.Precision(), must(tc.currency.Precision()); got != expected {
+			t.Fatalf("invalid precision of %s, expected %d, got %d", mn, expected, got)
+		}
+	}
+	if got, expected := must(NewMoney(Milli.MustParse("2.345"), "EUR")).String(), "2.34 EUR"; got != expected {
+		t.Fatalf("invalid money, expected %s, got %s", expected, got)
+	}
+}
+
+func Test_ParseMoney(t *testing.T) {
+	for _, tc := range [][3]string{
+		{"1.23", "usd", "1.23 USD"},
+		{"1.230", "USD", "1.23 USD"},
+		{"100", "JPY", "100 JPY"},
+		{"0.125", "KWD", "0.125 KWD"},
+	} {
+		if got := must(ParseMoney(tc[0], tc[1])).String(); got != tc[2] {
+			t.Fatalf("invalid money of %s %s, expected %s, got %s", tc[0], tc[1], tc[2], got)
+		}
+	}
+	if _, err := ParseMoney("1.005", "USD"); !errors.Is(err, ErrInvalidMoneyAmount) {
+		t.Fatalf("expected ErrInvalidMoneyAmount, got %v", err)
+	}
+	if _, err := ParseMoney("1.5", "JPY"); !errors.Is(err, ErrInvalidMoneyAmount) {
+		t.Fatalf("expected ErrInvalidMoneyAmount, got %v", err)
+	}
+	if _, err := ParseMoney("1", "ABC"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Fatalf("expected ErrUnknownCurrency, got %v", err)
+	}
+	if _, err := ParseMoney("x", "USD"); !errors.Is(err, ErrInvalidDecimalString) {
+		t.Fatalf("expected ErrInvalidDecimalString, got %v", err)
+	}
+}
+
+func Test_MoneyArithmetic(t *testing.T) {
+	a, b := MustParseMoney("10.25", "USD"), MustParseMoney("0.75", "USD")
+	if got, expected := must(a.Add(b)).String(), "11 USD"; got != expected {
+		t.Fatalf("invalid sum, expected %s, got %s", expected, got)
+	}
+	if got, expected := must(b.Sub(a)).String(), "-9.5 USD"; got != expected {
+		t.Fatalf("invalid difference, expected %s, got %s", expected, got)
+	}
+	if got := must(a.Cmp(b)); got != 1 {
+		t.Fatalf("invalid comparison, expected 1, got %d", got)
+	}
+	if got, expected := must(a.Mul(Milli.MustParse("0.075"), HalfEven)).String(), "0.77 USD"; got != expected {
+		t.Fatalf("invalid product, expected %s, got %s", expected, got)
+	}
+	if got, expected := must(a.Quo(FromInt64(3, Z), ToZero)).String(), "3.41 USD"; got != expected {
+		t.Fatalf("invalid quotient, expected %s, got %s", expected, got)
+	}
+	if _, err := a.Quo(Zero(Z), HalfEven); !errors.Is(err, ErrDivisionByZero) {
+		t.Fatalf("expected ErrDivisionByZero, got %v", err)
+	}
+	parts := must(MustParseMoney("100", "JPY").Allocate(One(Z), One(Z), One(Z)))
+	for i, expected := range []string{"34 JPY", "33 JPY", "33 JPY"} {
+		if got := parts[i].String(); got != expected {
+			t.Fatalf("invalid part %d, expected %s, got %s", i, expected, got)
+		}
+	}
+	// the extra digits of the amount are kept.
+	parts = must(Money{Amount: Milli.MustParse("0.01"), Currency: "USD"}.Allocate(One(Z), One(Z), One(Z)))
+	for i, expected := range []string{"0.004 USD", "0.003 USD", "0.003 USD"} {
+		if got := parts[i].String(); got != expected {
+			t.Fatalf("invalid part %d of the fine amount, expected %s, got %s", i, expected, got)
+		}
+	}
+	if a.Neg().Sign() != -1 || a.Neg().Abs().String() != "10.25 USD" || !MustParseMoney("0", "USD").IsZero() {
+		t.Fatal("invalid sign operations")
+	}
+
+	eur := MustParseMoney("1", "EUR")
+	if _, err := a.Add(eur); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+	if _, err := a.Sub(eur); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+	if _, err := a.Cmp(eur); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+	unknown := Money{Amount: One(Z), Currency: "XYZ"}
+	if _, err := unknown.Add(unknown); !errors.Is(err, ErrUnknownCurrency) {
+		t.Fatalf("expected ErrUnknownCurrency, got %v", err)
+	}
+}
+
+func Test_MoneyJSON(t *testing.T) {
+	data, err := json.Marshal(MustParseMoney("1.23", "USD"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, expected := string(data), `{"amount":"1.23","currency":"USD"}`; got != expected {
+		t.Fatalf("invalid json, expected %s, got %s", expected, got)
+	}
+	for _, tc := range []struct {
+		mn       Money
+		expected string
+	}{
+		{MustParseMoney("1.2", "USD"), `{"amount":"1.20","currency":"USD"}`},
+		{MustParseMoney("-0.5", "BHD"), `{"amount":"-0.500","currency":"BHD"}`},
+		{MustParseMoney("100", "JPY"), `{"amount":"100","currency":"JPY"}`},
+		{Money{Amount: Zero(Z), Currency: "EUR"}, `{"amount":"0.00","currency":"EUR"}`},
+		{Money{Amount: Micro.MustParse("1.000125"), Currency: "USD"}, `{"amount":"1.000125","currency":"USD"}`},
+	} {
+		if got := string(must(json.Marshal(tc.mn))); got != tc.expected {
+			t.Fatalf("invalid json of %s, expected %s, got %s", tc.mn, tc.expected, got)
+		}
+	}
+	var v struct {
+		Price Money `json:"price"`
+	}
+	if err := json.Unmarshal([]byte(`{"price":{"amount":"1.5","currency":"BHD"}}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Price.String() != "1.5 BHD" || v.Price.Amount.Precision() != Milli {
+		t.Fatalf("invalid unmarshalled money %s", v.Price)
+	}
+	if err := json.Unmarshal([]byte(`{"amount":"1.5","currency":"JPY"}`), &v.Price); !errors.Is(err, ErrInvalidMoneyAmount) {
+		t.Fatalf("expected ErrInvalidMoneyAmount, got %v", err)
+	}
+	if _, err := json.Marshal(Money{Amount: One(Z), Currency: "XYZ"}); !errors.Is(err, ErrUnknownCurrency) {
+		t.Fatalf("expected ErrUnknownCurrency, got %v", err)
+	}
+}
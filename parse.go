@@ -0,0 +1,161 @@
+package dec
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ParseError describes why the input isn't a valid decimal number, it wraps ErrInvalidDecimalString.
+type ParseError struct {
+	Input  string
+	Offset int // the byte offset of the error in the input.
+	Reason string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s: %q at offset %d: %s", ErrInvalidDecimalString, e.Input, e.Offset, e.Reason)
+}
+
+func (e *ParseError) Unwrap() error { return ErrInvalidDecimalString }
+
+// ParseOptions sets the syntax accepted by the parser, the zero value is the strict syntax:
+// an optional minus sign, the digits and an optional decimal point with the digits after it.
+type ParseOptions struct {
+	AllowPlus        bool // a leading plus sign: "+1".
+	AllowBareDot     bool // no digits on one side of the decimal point: ".5", "1.".
+	AllowUnderscores bool // the '_' separators between the digits: "1_000_000".
+	AllowExponent    bool // the signed decimal exponent: "1.5e-7", "2E+3".
+	TrimSpace        bool // the leading and the trailing whitespace.
+}
+
+var (
+	// StrictParsing accepts only the strict syntax.
+	StrictParsing = ParseOptions{}
+	// DefaultParsing is used by Parse, it accepts the strict syntax and a leading plus sign.
+	DefaultParsing = ParseOptions{AllowPlus: true}
+	// LenientParsing accepts all the supported extensions of the syntax.
+	LenientParsing = ParseOptions{AllowPlus: true, AllowBareDot: true, AllowUnderscores: true, AllowExponent: true, TrimSpace: true}
+	// sciParsing is used by ParseSci and the text decoding, it's DefaultParsing with the exponent.
+	sciParsing = ParseOptions{AllowPlus: true, AllowExponent: true}
+)
+
+// Parse parses the decimal number with the options, if the number has more fractional digits
+// than the precision they're truncated when limitPrecision is set, otherwise the precision is extended.
+func (o ParseOptions) Parse(val string, precision Precision, limitPrecision bool) (Decimal, error) {
+	return o.parse(val, precision, limitPrecision, ToZero)
+}
+
+// MustParse the same as Parse but panics on error.
+func (o ParseOptions) MustParse(val string, precision Precision, limitPrecision bool) Decimal {
+	return must(o.Parse(val, precision, limitPrecision))
+}
+
+// parse is Parse rounding the extra fractional digits using the mode m.
+func (o ParseOptions) parse(val string, precision Precision, limitPrecision bool, m RoundingMode) (Decimal, error) {
+	s, start := val, 0
+	if o.TrimSpace {
+		trimmed := strings.TrimLeftFunc(s, unicode.IsSpace)
+		start = len(s) - len(trimmed)
+		s = strings.TrimRightFunc(trimmed, unicode.IsSpace)
+	}
+	fail := func(i int, reason string) (Decimal, error) {
+		return Decimal{}, &ParseError{Input: val, Offset: start + i, Reason: reason}
+	}
+	if s == "" {
+		return fail(0, "empty number")
+	}
+
+	i, negative := 0, false
+	switch s[0] {
+	case '-':
+		i, negative = 1, true
+	case '+':
+		if !o.AllowPlus {
+			return fail(0, "leading plus sign")
+		}
+		i = 1
+	}
+	isDigit := func(i int) bool { return i >= 0 && i < len(s) && s[i] >= '0' && s[i] <= '9' }
+	var intPart, fracPart []byte
+	point, end := -1, len(s)
+	for ; i < end; i++ {
+		switch c := s[i]; {
+		case isDigit(i) && point < 0:
+			intPart = append(intPart, c)
+		case isDigit(i):
+			fracPart = append(fracPart, c)
+		case c == '.' && point < 0:
+			point = i
+		case c == '_' && o.AllowUnderscores:
+			if !isDigit(i-1) || !isDigit(i+1) {
+				return fail(i, "digit separator is not between digits")
+			}
+		case (c == 'e' || c == 'E') && o.AllowExponent:
+			end = i
+		default:
+			r, _ := utf8.DecodeRuneInString(s[i:])
+			return fail(i, fmt.Sprintf("unexpected character %q", r))
+		}
+	}
+	switch {
+	case len(intPart)+len(fracPart) == 0:
+		return fail(end, "no digits")
+	case point >= 0 && !o.AllowBareDot && len(intPart) == 0:
+		return fail(point, "no digits before the decimal point")
+	case point >= 0 && !o.AllowBareDot && len(fracPart) == 0:
+		return fail(point, "no digits after the decimal point")
+	}
+
+	exp := 0
+	if end < len(s) {
+		i = end + 1
+		expNegative := i < len(s) && s[i] == '-'
+		if i < len(s) && (s[i] == '-' || s[i] == '+') {
+			i++
+		}
+		if i == len(s) {
+			return fail(i, "no exponent digits")
+		}
+		for ; i < len(s); i++ {
+			if !isDigit(i) {
+				r, _ := utf8.DecodeRuneInString(s[i:])
+				return fail(i, fmt.Sprintf("unexpected character %q", r))
+			}
+			if exp = exp*10 + int(s[i]-'0'); exp > math.MaxUint16 {
+				return fail(end+1, "exponent is out of range")
+			}
+		}
+		if expNegative {
+			exp = -exp
+		}
+	}
+
+	units := &big.Int{}
+	if digits := string(intPart) + string(fracPart); digits != "" {
+		units.SetString(digits, 10)
+	}
+	if negative {
+		units.Neg(units)
+	}
+	// the number of the fractional digits to represent the value exactly.
+	scale := len(fracPart) - exp
+	switch {
+	case scale <= int(precision):
+		if scale < 0 {
+			units.Mul(units, pow10(int64(-scale)))
+			scale = 0
+		}
+		units.Mul(units, pow10(int64(int(precision)-scale)))
+	case limitPrecision:
+		quoRound(units, units, pow10(int64(scale-int(precision))), m)
+	case scale > math.MaxUint16:
+		return fail(max(point, 0), "too many fractional digits")
+	default:
+		precision = Precision(scale)
+	}
+	return FromUnits(units, precision), nil
+}
@@ -0,0 +1,124 @@
+package dec
+
+import (
+	"errors"
+	"math/big"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func Test_ParseOptions(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		opts     ParseOptions
+		expected string
+	}{
+		{"-0.5", StrictParsing, "-0.5"},
+		{"-0.05", DefaultParsing, "-0.05"},
+		{"-0", StrictParsing, "0"},
+		{"+1.25", DefaultParsing, "1.25"},
+		{"007.100", StrictParsing, "7.1"},
+		{".5", LenientParsing, "0.5"},
+		{"-.5", LenientParsing, "-0.5"},
+		{"1.", LenientParsing, "1"},
+		{"1_000_000.000_1", LenientParsing, "1000000.0001"},
+		{" \t-12.5\n", LenientParsing, "-12.5"},
+		{"+.5", ParseOptions{AllowPlus: true, AllowBareDot: true}, "0.5"},
+		{"-1.5e-3", LenientParsing, "-0.0015"},
+		{"1_000E+2", LenientParsing, "100000"},
+	} {
+		d, err := tc.opts.Parse(tc.input, Centi, false)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", tc.input, err)
+		}
+		if got := d.String(); got != tc.expected {
+			t.Fatalf("invalid value of %q, expected %s, got %s", tc.input, tc.expected, got)
+		}
+	}
+}
+
+func Test_ParseError(t *testing.T) {
+	for _, tc := range []struct {
+		input  string
+		opts   ParseOptions
+		offset int
+		reason string
+	}{
+		{"", LenientParsing, 0, "empty number"},
+		{"   ", LenientParsing, 3, "empty number"},
+		{"-", StrictParsing, 1, "no digits"},
+		{"1.-5", LenientParsing, 2, "unexpected character '-'"},
+		{"1.2.3", LenientParsing, 3, "unexpected character '.'"},
+		{"--1", StrictParsing, 1, "unexpected character '-'"},
+		{"+1", StrictParsing, 0, "leading plus sign"},
+		{".5", DefaultParsing, 0, "no digits before the decimal point"},
+		{"-5.", DefaultParsing, 2, "no digits after the decimal point"},
+		{".", LenientParsing, 1, "no digits"},
+		{"1_000", DefaultParsing, 1, "unexpected character '_'"},
+		{"1__000", LenientParsing, 1, "digit separator is not between digits"},
+		{"_1", LenientParsing, 0, "digit separator is not between digits"},
+		{"1_.5", LenientParsing, 1, "digit separator is not between digits"},
+		{"  1 2", LenientParsing, 3, "unexpected character ' '"},
+		{" 1", DefaultParsing, 0, "unexpected character ' '"},
+		{"1,5", LenientParsing, 1, "unexpected character ','"},
+		{"1e5", DefaultParsing, 1, "unexpected character 'e'"},
+		{"1e", LenientParsing, 2, "no exponent digits"},
+		{"1e+", LenientParsing, 3, "no exponent digits"},
+		{"e5", LenientParsing, 0, "no digits"},
+		{"1e5e1", LenientParsing, 3, "unexpected character 'e'"},
+		{"1e1.5", LenientParsing, 3, "unexpected character '.'"},
+		{"1e99999", LenientParsing, 2, "exponent is out of range"},
+		{"1e-99999", LenientParsing, 2, "exponent is out of range"},
+		{"1²", LenientParsing, 1, "unexpected character '²'"},
+	} {
+		_, err := tc.opts.Parse(tc.input, Centi, false)
+		if !errors.Is(err, ErrInvalidDecimalString) {
+			t.Fatalf("expected ErrInvalidDecimalString for %q, got %v", tc.input, err)
+		}
+		var pe *ParseError
+		if !errors.As(err, &pe) {
+			t.Fatalf("expected *ParseError for %q, got %T", tc.input, err)
+		}
+		if pe.Input != tc.input || pe.Offset != tc.offset || pe.Reason != tc.reason {
+			t.Fatalf("invalid error of %q, expected %q at %d, got %q at %d", tc.input, tc.reason, tc.offset, pe.Reason, pe.Offset)
+		}
+	}
+}
+
+func Test_ParseUnitsError(t *testing.T) {
+	for input, offset := range map[string]int{"": 0, "1.5": 1, "12a": 2, "-": 1} {
+		var pe *ParseError
+		if _, err := Nano.ParseUnits(input); !errors.As(err, &pe) || pe.Offset != offset {
+			t.Fatalf("expected *ParseError at %d for %q, got %v", offset, input, err)
+		}
+	}
+}
+
+var strictSyntax = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+func Fuzz_Parse(f *testing.F) {
+	for _, s := range []string{"0", "-0.5", "1.-5", "+1", ".5", "1.", "1_000.5", " 12.34 ", "-007.0100", "1e5", "0x10"} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		d, err := LenientParsing.Parse(s, Z, false)
+		if err != nil {
+			if strictSyntax.MatchString(s) {
+				t.Fatalf("failed to parse %q: %v", s, err)
+			}
+			return
+		}
+		r, ok := new(big.Rat).SetString(strings.ReplaceAll(strings.TrimSpace(s), "_", ""))
+		if !ok {
+			t.Fatalf("%q is parsed as %s but it isn't a rational number", s, d)
+		}
+		if new(big.Rat).SetFrac(d.Units(), d.Precision().multiplierOnlyForReadIPromise()).Cmp(r) != 0 {
+			t.Fatalf("%q is parsed as %s, expected %s", s, d, r.RatString())
+		}
+		back, err := StrictParsing.Parse(d.String(), Z, false)
+		if err != nil || back.Cmp(d) != 0 {
+			t.Fatalf("round trip of %q failed: %s is parsed as %s, %v", s, d, back, err)
+		}
+	})
+}
@@ -0,0 +1,316 @@
+package dec
+
+import (
+	"container/list"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/pr0n1x/go-liners/werr"
+)
+
+var ErrInvalidPattern = werr.New("invalid number pattern")
+
+// Pattern is a compiled ICU/Excel-style number pattern, it's immutable and safe for concurrent use.
+//
+// The pattern consists of a positive subpattern and an optional negative one separated by ';',
+// the negative subpattern only defines its prefix and suffix: "#,##0.00;(#,##0.00)".
+// The number part supports the digits '0' (required), '#' (optional), ',' (grouping, the last two
+// commas set the primary and the secondary group sizes: "#,##,##0") and '.' (the decimal point),
+// or the significant digits '@' (required) followed by '#' (optional): "@@#".
+// The prefix and the suffix are literal, '%' and '‰' multiply the value by 100 and 1000,
+// the special characters could be quoted: "'#'0", a doubled quote is a literal one.
+type Pattern struct {
+	source             string
+	positive, negative affixes
+	multiplier         int // the power of ten the value is multiplied by.
+	minInt             int
+	minFrac, maxFrac   int
+	minSig, maxSig     int // the significant digits, zero if not used.
+	primary, secondary int // the group sizes, zero if not grouped.
+}
+
+type affixes struct {
+	prefix, suffix string
+}
+
+// patternCacheSize limits the number of the compiled patterns cached by Format and FormatRound,
+// use CompilePattern to keep a pattern compiled regardless of the cache.
+const patternCacheSize = 256
+
+var patternCache = patternLRU{items: make(map[string]*list.Element)}
+
+// patternLRU keeps the recently used compiled patterns evicting the least recently used one.
+type patternLRU struct {
+	mu    sync.Mutex
+	items map[string]*list.Element
+	order list.List // *Pattern values, the most recently used first.
+}
+
+func (c *patternLRU) get(pattern string) (*Pattern, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[pattern]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(*Pattern), true
+}
+
+func (c *patternLRU) put(pattern string, p *Pattern) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[pattern]; ok {
+		c.order.MoveToFront(e)
+		return
+	}
+	c.items[pattern] = c.order.PushFront(p)
+	if c.order.Len() > patternCacheSize {
+		last := c.order.Back()
+		c.order.Remove(last)
+		delete(c.items, last.Value.(*Pattern).source)
+	}
+}
+
+// CompilePattern compiles the pattern to format the decimals repeatedly.
+func CompilePattern(pattern string) (*Pattern, error) {
+	positive, negative, hasNegative := pattern, "", false
+	quoted := false
+	for i, r := range pattern {
+		if r == '\'' {
+			quoted = !quoted
+		} else if r == ';' && !quoted {
+			positive, negative, hasNegative = pattern[:i], pattern[i+1:], true
+			break
+		}
+	}
+	p := &Pattern{source: pattern}
+	number, err := p.parseSubpattern(positive, &p.positive)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.parseNumber(number); err != nil {
+		return nil, err
+	}
+	if hasNegative {
+		if _, err := p.parseSubpattern(negative, &p.negative); err != nil {
+			return nil, err
+		}
+	} else {
+		p.negative = affixes{prefix: "-" + p.positive.prefix, suffix: p.positive.suffix}
+	}
+	return p, nil
+}
+
+// MustCompilePattern the same as CompilePattern but panics on error.
+func MustCompilePattern(pattern string) *Pattern {
+	return must(CompilePattern(pattern))
+}
+
+// Format formats d with the pattern using the HalfEven mode, the recently used compiled patterns are cached.
+func Format(d Decimal, pattern string) (string, error) {
+	return FormatRound(d, pattern, HalfEven)
+}
+
+// FormatRound formats d with the pattern using the rounding mode m, the recently used compiled patterns are cached.
+func FormatRound(d Decimal, pattern string, m RoundingMode) (string, error) {
+	if cached, ok := patternCache.get(pattern); ok {
+		return cached.Format(d, m), nil
+	}
+	p, err := CompilePattern(pattern)
+	if err != nil {
+		return "", err
+	}
+	patternCache.put(pattern, p)
+	return p.Format(d, m), nil
+}
+
+// Format formats d rounding it to the digits of the pattern using the mode m.
+func (p *Pattern) Format(d Decimal, m RoundingMode) string {
+	if !m.valid() {
+		panic("invalid rounding mode")
+	}
+	units, exp := d.Units(), int(d.Precision())-p.multiplier
+	if p.maxSig > 0 {
+		if units.Sign() != 0 {
+			// the number of the integer digits is the position of the decimal point.
+			intDigits := len(new(big.Int).Abs(units).String()) - exp
+			exp = roundFraction(units, exp, p.maxSig-intDigits, m)
+		}
+	} else {
+		exp = roundFraction(units, exp, p.maxFrac, m)
+	}
+
+	a := p.positive
+	if units.Sign() < 0 {
+		a = p.negative
+	}
+	digs := units.Abs(units).String()
+	minInt, minFrac := p.minInt, p.minFrac
+	if p.maxSig > 0 {
+		// drop the trailing zeros to count the significant digits.
+		trimmed := strings.TrimRight(digs, "0")
+		exp -= len(digs) - len(trimmed)
+		digs = trimmed
+		minInt = 1
+		if digs == "" {
+			exp, minFrac = 0, p.minSig-1
+		} else {
+			minFrac = max(exp+p.minSig-len(digs), 0)
+		}
+	}
+	// split the digits into the integer and the fractional parts.
+	var intPart, fracPart string
+	switch {
+	case exp <= 0:
+		intPart = digs + strings.Repeat("0", -exp)
+	case exp >= len(digs):
+		fracPart = strings.Repeat("0", exp-len(digs)) + digs
+	default:
+		intPart, fracPart = digs[:len(digs)-exp], digs[len(digs)-exp:]
+	}
+	intPart = strings.TrimLeft(intPart, "0")
+	fracPart = strings.TrimRight(fracPart, "0")
+	if len(intPart) < minInt {
+		intPart = strings.Repeat("0", minInt-len(intPart)) + intPart
+	}
+	if len(fracPart) < minFrac {
+		fracPart += strings.Repeat("0", minFrac-len(fracPart))
+	}
+	if intPart == "" && fracPart == "" {
+		intPart = "0"
+	}
+
+	var b strings.Builder
+	b.WriteString(a.prefix)
+	b.WriteString(p.group(intPart))
+	if fracPart != "" {
+		b.WriteByte('.')
+		b.WriteString(fracPart)
+	}
+	b.WriteString(a.suffix)
+	return b.String()
+}
+
+// roundFraction rounds units * 10^-exp to frac fractional digits using the mode m
+// and returns the new exponent, frac could be negative to round to tens, hundreds and so on.
+func roundFraction(units *big.Int, exp, frac int, m RoundingMode) int {
+	if frac >= exp {
+		return exp
+	}
+	quoRound(units, units, pow10(int64(exp-frac)), m)
+	return frac
+}
+
+// group inserts the grouping separators into the integer digits.
+func (p *Pattern) group(digits string) string {
+	if p.primary == 0 || len(digits) <= p.primary {
+		return digits
+	}
+	size := p.secondary
+	if size == 0 {
+		size = p.primary
+	}
+	head, tail := digits[:len(digits)-p.primary], digits[len(digits)-p.primary:]
+	var groups []string
+	for len(head) > size {
+		groups = append([]string{head[len(head)-size:]}, groups...)
+		head = head[:len(head)-size]
+	}
+	groups = append([]string{head}, groups...)
+	return strings.Join(append(groups, tail), ",")
+}
+
+// parseSubpattern reads the prefix and the suffix of the subpattern into a and returns its number part.
+func (p *Pattern) parseSubpattern(subpattern string, a *affixes) (string, error) {
+	var prefix, number, suffix strings.Builder
+	state, quoted := 0, false
+	runes := []rune(subpattern)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if r == '\'' {
+			if i+1 < len(runes) && runes[i+1] == '\'' {
+				i++
+			} else {
+				quoted = !quoted
+				continue
+			}
+		} else if !quoted && strings.ContainsRune("0#,.@", r) {
+			if state == 2 {
+				return "", ErrInvalidPattern.Explainf("%q: number characters in the suffix", subpattern)
+			}
+			state = 1
+			number.WriteRune(r)
+			continue
+		} else if !quoted && (r == '%' || r == '‰') {
+			multiplier := 2
+			if r == '‰' {
+				multiplier = 3
+			}
+			if a == &p.positive {
+				if p.multiplier != 0 {
+					return "", ErrInvalidPattern.Explainf("%q: several percent signs", subpattern)
+				}
+				p.multiplier = multiplier
+			}
+		}
+		if state == 0 {
+			prefix.WriteRune(r)
+		} else {
+			state = 2
+			suffix.WriteRune(r)
+		}
+	}
+	if quoted {
+		return "", ErrInvalidPattern.Explainf("%q: unterminated quote", subpattern)
+	}
+	if number.Len() == 0 {
+		return "", ErrInvalidPattern.Explainf("%q: no digits", subpattern)
+	}
+	a.prefix, a.suffix = prefix.String(), suffix.String()
+	return number.String(), nil
+}
+
+// parseNumber reads the digits and the grouping of the number part of the positive subpattern.
+func (p *Pattern) parseNumber(number string) error {
+	intPart, fracPart, hasPoint := strings.Cut(number, ".")
+	if strings.ContainsAny(fracPart, ".,@") {
+		return ErrInvalidPattern.Explainf("%q: invalid fraction", number)
+	}
+	if groups := strings.Split(intPart, ","); len(groups) > 1 {
+		p.primary = len(groups[len(groups)-1])
+		if len(groups) > 2 {
+			p.secondary = len(groups[len(groups)-2])
+		}
+		if p.primary == 0 || len(groups) > 2 && p.secondary == 0 {
+			return ErrInvalidPattern.Explainf("%q: empty group", number)
+		}
+		if p.secondary == p.primary {
+			p.secondary = 0
+		}
+	}
+	digits := strings.ReplaceAll(intPart, ",", "")
+	if strings.ContainsRune(digits, '@') {
+		if hasPoint || strings.ContainsRune(digits, '0') {
+			return ErrInvalidPattern.Explainf("%q: significant digits with the required ones", number)
+		}
+		sig := strings.TrimLeft(digits, "#")
+		p.maxSig = len(sig)
+		p.minSig = p.maxSig - len(strings.TrimLeft(sig, "@"))
+		if strings.Trim(sig[p.minSig:], "#") != "" {
+			return ErrInvalidPattern.Explainf("%q: optional digit before the significant one", number)
+		}
+		return nil
+	}
+	p.minInt = len(strings.TrimLeft(digits, "#"))
+	if strings.ContainsRune(digits[len(digits)-p.minInt:], '#') {
+		return ErrInvalidPattern.Explainf("%q: optional digit after the required one", number)
+	}
+	p.minFrac = len(strings.TrimRight(fracPart, "#"))
+	p.maxFrac = len(fracPart)
+	if strings.ContainsRune(fracPart[:p.minFrac], '#') {
+		return ErrInvalidPattern.Explainf("%q: required digit after the optional one", number)
+	}
+	return nil
+}
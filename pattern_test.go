@@ -0,0 +1,102 @@
+package dec
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func Test_FormatPattern(t *testing.T) {
+	for _, tc := range []struct {
+		d        Decimal
+		pattern  string
+		m        RoundingMode
+		expected string
+	}{
+		{Milli.MustParse("1234567.891"), "#,##0.00", HalfEven, "1,234,567.89"},
+		{Milli.MustParse("1234567.891"), "#,##0.00", Ceiling, "1,234,567.90"},
+		{Centi.MustParse("0.5"), "#,##0.00", HalfEven, "0.50"},
+		{Milli.MustParse("2.345"), "#,##0.00", HalfEven, "2.34"},
+		{Milli.MustParse("2.345"), "#,##0.00", HalfUp, "2.35"},
+		{Deci.MustParse("1.5"), "0.###", HalfEven, "1.5"},
+		{FromInt64(2, Z), "0.###", HalfEven, "2"},
+		{MustParse("3.14159", 5, true), "0.###", HalfEven, "3.142"},
+		{Centi.MustParse("0.25"), "#.##", HalfEven, ".25"},
+		{Zero(Z), "#.##", HalfEven, "0"},
+		{Deci.MustParse("1234.5").Neg(), "#,##0.00;(#,##0.00)", HalfEven, "(1,234.50)"},
+		{Deci.MustParse("1234.5"), "#,##0.00;(#,##0.00)", HalfEven, "1,234.50"},
+		{Milli.MustParse("0.001").Neg(), "#,##0.00;(#,##0.00)", HalfEven, "0.00"},
+		{Milli.MustParse("0.001").Neg(), "#,##0.00", ToNegativeInf, "-0.01"},
+		{FromInt64(-5, Z), "$#,##0", HalfEven, "-$5"},
+		{MustParse("0.1234", 4, true), "0.0%", HalfEven, "12.3%"},
+		{MustParse("0.0035", 4, true), "0‰", HalfEven, "4‰"},
+		{MustParse("0.0025", 4, true), "0‰", HalfEven, "2‰"},
+		{FromInt64(1234567, Z), "#,##,##0.00", HalfEven, "12,34,567.00"},
+		{FromInt64(123, Z), "#,##,##0", HalfEven, "123"},
+		{FromInt64(1234567890, Z), "#,####", HalfEven, "12,3456,7890"},
+		{FromInt64(12, Z), "00000", HalfEven, "00012"},
+		{Deci.MustParse("1234.5"), "@@@", HalfEven, "1230"},
+		{Deci.MustParse("1235.5"), "@@@", HalfEven, "1240"},
+		{Deci.MustParse("1.5"), "@@@", HalfEven, "1.50"},
+		{Deci.MustParse("1.5"), "@@#", HalfEven, "1.5"},
+		{One(Z), "@@#", HalfEven, "1.0"},
+		{MustParse("0.012345", 6, true), "@@#", HalfEven, "0.0123"},
+		{MustParse("0.012", 6, true), "@@@", HalfEven, "0.0120"},
+		{Zero(Z), "@@@", HalfEven, "0.00"},
+		{MustParse("999.9", 1, true), "@@", HalfEven, "1000"},
+		{FromInt64(5, Z), "'#'0 'items'", HalfEven, "#5 items"},
+		{FromInt64(5, Z), "0 o''clock", HalfEven, "5 o'clock"},
+		{FromInt64(-5, Z), "0' ;';'<'0'>'", HalfEven, "<5>"},
+	} {
+		got, err := FormatRound(tc.d, tc.pattern, tc.m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.expected {
+			t.Fatalf("invalid format of %s with %q, expected %q, got %q", tc.d, tc.pattern, tc.expected, got)
+		}
+	}
+	if got, expected := must(Format(Milli.MustParse("1.005"), "0.00")), "1.00"; got != expected {
+		t.Fatalf("invalid default rounding, expected %s, got %s", expected, got)
+	}
+}
+
+func Test_CompilePattern(t *testing.T) {
+	p := MustCompilePattern("#,##0.00 ¤;-#,##0.00 ¤")
+	if got, expected := p.Format(Centi.MustParse("1234.5"), HalfEven), "1,234.50 ¤"; got != expected {
+		t.Fatalf("invalid format, expected %s, got %s", expected, got)
+	}
+	for _, pattern := range []string{"", "abc", "0.0.0", "#,##0.0,0", "0#", "0.#0", "@0", "@#@", "0%%", "0 '", "#,", "0 x 0"} {
+		if _, err := CompilePattern(pattern); !errors.Is(err, ErrInvalidPattern) {
+			t.Fatalf("expected ErrInvalidPattern for %q, got %v", pattern, err)
+		}
+	}
+	if _, err := Format(One(Z), "x"); !errors.Is(err, ErrInvalidPattern) {
+		t.Fatalf("expected ErrInvalidPattern, got %v", err)
+	}
+}
+
+func Test_PatternCacheBound(t *testing.T) {
+	d := Centi.MustParse("1.5")
+	if _, err := Format(d, "0.00"); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2*patternCacheSize; i++ {
+		if _, err := Format(d, fmt.Sprintf("'#%d '0.00", i)); err != nil {
+			t.Fatal(err)
+		}
+		// keep the first pattern recently used.
+		if got := must(Format(d, "0.00")); got != "1.50" {
+			t.Fatalf("invalid formatting, got %s", got)
+		}
+	}
+	if got := len(patternCache.items); got > patternCacheSize || patternCache.order.Len() != got {
+		t.Fatalf("pattern cache is not bounded: %d items", got)
+	}
+	if _, ok := patternCache.get("0.00"); !ok {
+		t.Fatal("recently used pattern is evicted")
+	}
+	if _, ok := patternCache.get("'#0 '0.00"); ok {
+		t.Fatal("least recently used pattern is not evicted")
+	}
+}
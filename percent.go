@@ -0,0 +1,200 @@
+package dec
+
+import (
+	"math"
+	"strings"
+
+	"github.com/pr0n1x/go-liners/werr"
+)
+
+var (
+	ErrInvalidMargin  = werr.New("margin should be less than 100%")
+	ErrInvalidMarkup  = werr.New("markup should be greater than -100%")
+	ErrInvalidGrowth  = werr.New("growth requires the beginning and ending values of the same sign")
+	ErrInvalidPeriods = werr.New("number of periods should be positive")
+	ErrTooManyPeriods = werr.New("number of periods times the precision exceeds the maximum precision")
+)
+
+// Rate is a proportion of a whole like Percent or BasisPoints.
+type Rate interface {
+	Ratio() Decimal
+}
+
+// Percent is a number of hundredths.
+type Percent Decimal
+
+// BasisPoints is a number of hundredths of a percent.
+type BasisPoints Decimal
+
+// percentSuffixes maps the suffixes to the decimal exponents of their units.
+var percentSuffixes = []struct {
+	suffix string
+	exp    int
+}{
+	{"%", 2},
+	{"‰", 3},
+	{"bps", 4},
+	{"bp", 4},
+}
+
+// ParsePercent parses a percent ("12.5%"), a per-mille ("3‰") or a basis points ("35bps") value to Percent,
+// a number without a suffix is taken as a percent. The precision is the minimal one,
+// it's extended to keep all the digits of the value and the converted value exact.
+func ParsePercent(s string, precision Precision) (Percent, error) {
+	d, err := parseProportion(s, precision, 2)
+	return Percent(d), err
+}
+
+// ParseBasisPoints is the same as ParsePercent but a number without a suffix is taken as basis points.
+func ParseBasisPoints(s string, precision Precision) (BasisPoints, error) {
+	d, err := parseProportion(s, precision, 4)
+	return BasisPoints(d), err
+}
+
+// parseProportion parses the value with an optional suffix and converts it to the units of the exponent exp.
+func parseProportion(s string, precision Precision, exp int) (Decimal, error) {
+	s = strings.TrimSpace(s)
+	unit := exp
+	for _, ps := range percentSuffixes {
+		if number, ok := strings.CutSuffix(s, ps.suffix); ok {
+			s, unit = strings.TrimSpace(number), ps.exp
+			break
+		}
+	}
+	d, err := Parse(s, precision, false)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return movePoint(d, exp-unit), nil
+}
+
+// PercentFromRatio returns the ratio (0.125) as Percent (12.5%).
+func PercentFromRatio(ratio Decimal) Percent {
+	return Percent(movePoint(ratio, 2))
+}
+
+// BasisPointsFromRatio returns the ratio (0.0035) as BasisPoints (35bps).
+func BasisPointsFromRatio(ratio Decimal) BasisPoints {
+	return BasisPoints(movePoint(ratio, 4))
+}
+
+// Ratio returns the exact ratio of the percent: 12.5% is 0.125.
+func (pc Percent) Ratio() Decimal {
+	return movePoint(Decimal(pc), -2)
+}
+
+// BasisPoints returns the exact value of the percent in basis points.
+func (pc Percent) BasisPoints() BasisPoints {
+	return BasisPoints(movePoint(Decimal(pc), 2))
+}
+
+func (pc Percent) String() string {
+	return Decimal(pc).String() + "%"
+}
+
+// Ratio returns the exact ratio of the basis points: 35bps is 0.0035.
+func (bp BasisPoints) Ratio() Decimal {
+	return movePoint(Decimal(bp), -4)
+}
+
+// Percent returns the exact value of the basis points in percents.
+func (bp BasisPoints) Percent() Percent {
+	return Percent(movePoint(Decimal(bp), -2))
+}
+
+func (bp BasisPoints) String() string {
+	return Decimal(bp).String() + "bps"
+}
+
+// ApplyFee returns the fee of the amount rounded to p using the mode m and the amount net of the fee,
+// net + fee is always equal to the amount.
+func ApplyFee(amount Decimal, fee Rate, p Precision, m RoundingMode) (net, charged Decimal) {
+	charged = amount.MulRound(fee.Ratio(), p, m).Rescale(p)
+	return amount.Sub(charged), charged
+}
+
+// AddMarkup returns the cost increased by the markup rounded to p using the mode m.
+func AddMarkup(cost Decimal, markup Rate, p Precision, m RoundingMode) Decimal {
+	return cost.MulRound(One(Z).Add(markup.Ratio()), p, m).Rescale(p)
+}
+
+// MarginToMarkup converts the margin (a share of the price) to the markup (a share of the cost):
+// margin / (1 - margin). The result is rounded to p digits of the percent using the mode m.
+func MarginToMarkup(margin Rate, p Precision, m RoundingMode) (Percent, error) {
+	ratio := margin.Ratio()
+	base := One(Z).Sub(ratio)
+	if base.Sign() <= 0 {
+		return Percent{}, ErrInvalidMargin
+	}
+	return PercentFromRatio(ratio.QuoRound(base, p+2, m)), nil
+}
+
+// MarkupToMargin converts the markup (a share of the cost) to the margin (a share of the price):
+// markup / (1 + markup). The result is rounded to p digits of the percent using the mode m.
+func MarkupToMargin(markup Rate, p Precision, m RoundingMode) (Percent, error) {
+	ratio := markup.Ratio()
+	base := One(Z).Add(ratio)
+	if base.Sign() <= 0 {
+		return Percent{}, ErrInvalidMarkup
+	}
+	return PercentFromRatio(ratio.QuoRound(base, p+2, m)), nil
+}
+
+// PercentChange returns the relative change from one value to another rounded to p digits of the percent using the mode m.
+// It returns ErrDivisionByZero if from is zero.
+func PercentChange(from, to Decimal, p Precision, m RoundingMode) (Percent, error) {
+	change, err := to.Sub(from).TryQuoRound(from.Abs(), p+2, m)
+	if err != nil {
+		return Percent{}, err
+	}
+	return PercentFromRatio(change), nil
+}
+
+// CAGR returns the compound growth rate per period (end/begin)^(1/periods) - 1
+// correctly rounded to p digits of the percent using the mode m.
+func CAGR(begin, end Decimal, periods int64, p Precision, m RoundingMode) (Percent, error) {
+	if periods < 1 {
+		return Percent{}, ErrInvalidPeriods
+	}
+	if begin.Sign() == 0 {
+		return Percent{}, ErrDivisionByZero
+	}
+	if end.Sign() != 0 && end.Sign() != begin.Sign() {
+		return Percent{}, ErrInvalidGrowth
+	}
+	// the root is truncated to rp = p+3 digits of the ratio, the n-th powers of the truncated roots have n*rp digits,
+	// so the quotient truncated to n*rp digits with a sticky digit has the same truncated root as the exact one.
+	rp := int64(p) + 3
+	if periods >= math.MaxUint16 || periods*rp >= math.MaxUint16 {
+		return Percent{}, ErrTooManyPeriods.Explainf("%d periods with %d digits", periods, p)
+	}
+	qp := periods * rp
+	num, den := end.Units(), begin.Units()
+	if shift := qp + int64(begin.Precision()) - int64(end.Precision()); shift >= 0 {
+		num.Mul(num, pow10(shift))
+	} else {
+		den.Mul(den, pow10(-shift))
+	}
+	var quo Decimal
+	if quoRound(num, num, den, ToZero) {
+		quo = FromUnits(num, Precision(qp))
+	} else {
+		quo = FromUnits(appendSticky(num, 1), Precision(qp+1))
+	}
+	root := must(quo.NthRoot(periods, Precision(rp), ToZero))
+	if root.Pow(periods, Precision(qp), ToZero).Cmp(quo) != 0 {
+		root = FromUnits(appendSticky(root.Units(), 1), Precision(rp+1))
+	}
+	growth := roundTo(root.Sub(One(Z)), p+2, m)
+	return PercentFromRatio(growth), nil
+}
+
+// movePoint returns d * 10^k keeping the units and changing the precision if possible.
+func movePoint(d Decimal, k int) Decimal {
+	p := int(d.Precision())
+	if p >= k {
+		return FromUnits(d.Units(), Precision(p-k))
+	}
+	units := d.Units()
+	return FromUnits(units.Mul(units, pow10(int64(k-p))), Z)
+}
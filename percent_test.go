@@ -0,0 +1,194 @@
+package dec
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_ParsePercent(t *testing.T) {
+	for _, tc := range []struct {
+		input, percent, ratio string
+	}{
+		{"12.5%", "12.5%", "0.125"},
+		{" 12.5 % ", "12.5%", "0.125"},
+		{"35bps", "0.35%", "0.0035"},
+		{"35 bp", "0.35%", "0.0035"},
+		{"3‰", "0.3%", "0.003"},
+		{"-0.5%", "-0.5%", "-0.005"},
+		{"7", "7%", "0.07"},
+		{"0.123456%", "0.123456%", "0.00123456"},
+	} {
+		pc, err := ParsePercent(tc.input, Centi)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := pc.String(); got != tc.percent {
+			t.Fatalf("invalid percent of %q, expected %s, got %s", tc.input, tc.percent, got)
+		}
+		if got := pc.Ratio().String(); got != tc.ratio {
+			t.Fatalf("invalid ratio of %q, expected %s, got %s", tc.input, tc.ratio, got)
+		}
+	}
+	for _, input := range []string{"", "%", "abc%", "--1%", "1%%", "-+1bps"} {
+		if _, err := ParsePercent(input, Centi); !errors.Is(err, ErrInvalidDecimalString) {
+			t.Fatalf("expected ErrInvalidDecimalString for %q, got %v", input, err)
+		}
+	}
+}
+
+func Test_BasisPoints(t *testing.T) {
+	for _, tc := range []struct {
+		input, bps, percent string
+	}{
+		{"35bps", "35bps", "0.35%"},
+		{"35", "35bps", "0.35%"},
+		{"1.25%", "125bps", "1.25%"},
+		{"3‰", "30bps", "0.3%"},
+		{"0.5bp", "0.5bps", "0.005%"},
+	} {
+		bp, err := ParseBasisPoints(tc.input, Z)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := bp.String(); got != tc.bps {
+			t.Fatalf("invalid basis points of %q, expected %s, got %s", tc.input, tc.bps, got)
+		}
+		if got := bp.Percent().String(); got != tc.percent {
+			t.Fatalf("invalid percent of %q, expected %s, got %s", tc.input, tc.percent, got)
+		}
+		if bp.Percent().BasisPoints().Ratio().Cmp(bp.Ratio()) != 0 {
+			t.Fatalf("lossy conversion of %q", tc.input)
+		}
+	}
+	if got, expected := PercentFromRatio(Milli.MustParse("0.125")).String(), "12.5%"; got != expected {
+		t.Fatalf("invalid percent from ratio, expected %s, got %s", expected, got)
+	}
+	if got, expected := BasisPointsFromRatio(FromInt64(2, Z)).String(), "20000bps"; got != expected {
+		t.Fatalf("invalid basis points from ratio, expected %s, got %s", expected, got)
+	}
+}
+
+func Test_Fees(t *testing.T) {
+	bps := must(ParseBasisPoints("35bps", Z))
+	card := must(ParsePercent("2.9%", Z))
+	for _, tc := range []struct {
+		amount      Decimal
+		fee         Rate
+		m           RoundingMode
+		net, charge string
+	}{
+		{Centi.MustParse("1000"), bps, HalfEven, "996.5", "3.5"},
+		{Centi.MustParse("19.99"), card, HalfEven, "19.41", "0.58"},
+		{Centi.MustParse("19.99"), card, ToZero, "19.42", "0.57"},
+		{Centi.MustParse("0.01"), bps, HalfEven, "0.01", "0"},
+		{Centi.MustParse("0.01"), bps, Ceiling, "0", "0.01"},
+	} {
+		net, charged := ApplyFee(tc.amount, tc.fee, Centi, tc.m)
+		if net.String() != tc.net || charged.String() != tc.charge {
+			t.Fatalf("invalid fee %s of %s, expected %s and %s, got %s and %s", tc.fee, tc.amount, tc.net, tc.charge, net, charged)
+		}
+		if net.Add(charged).Cmp(tc.amount) != 0 {
+			t.Fatalf("net and fee of %s don't sum up to the amount", tc.amount)
+		}
+	}
+	markup := must(ParsePercent("25%", Z))
+	if got, expected := AddMarkup(FromInt64(80, Z), markup, Centi, HalfEven).String(), "100"; got != expected {
+		t.Fatalf("invalid markup, expected %s, got %s", expected, got)
+	}
+	if got, expected := AddMarkup(Centi.MustParse("9.99"), card, Centi, HalfUp).String(), "10.28"; got != expected {
+		t.Fatalf("invalid markup, expected %s, got %s", expected, got)
+	}
+}
+
+func Test_MarginMarkup(t *testing.T) {
+	for _, tc := range []struct {
+		margin, markup string
+	}{
+		{"20%", "25%"},
+		{"50%", "100%"},
+		{"0%", "0%"},
+		{"-25%", "-20%"},
+	} {
+		if got := must(MarginToMarkup(must(ParsePercent(tc.margin, Z)), Centi, HalfEven)).String(); got != tc.markup {
+			t.Fatalf("invalid markup of margin %s, expected %s, got %s", tc.margin, tc.markup, got)
+		}
+		if got := must(MarkupToMargin(must(ParsePercent(tc.markup, Z)), Centi, HalfEven)).String(); got != tc.margin {
+			t.Fatalf("invalid margin of markup %s, expected %s, got %s", tc.markup, tc.margin, got)
+		}
+	}
+	if got, expected := must(MarginToMarkup(must(ParsePercent("30%", Z)), Centi, HalfEven)).String(), "42.86%"; got != expected {
+		t.Fatalf("invalid markup, expected %s, got %s", expected, got)
+	}
+	if _, err := MarginToMarkup(must(ParsePercent("100%", Z)), Centi, HalfEven); !errors.Is(err, ErrInvalidMargin) {
+		t.Fatalf("expected ErrInvalidMargin, got %v", err)
+	}
+	if _, err := MarkupToMargin(must(ParseBasisPoints("-10000", Z)), Centi, HalfEven); !errors.Is(err, ErrInvalidMarkup) {
+		t.Fatalf("expected ErrInvalidMarkup, got %v", err)
+	}
+}
+
+func Test_PercentChange(t *testing.T) {
+	for _, tc := range []struct {
+		from, to Decimal
+		expected string
+	}{
+		{FromInt64(80, Z), FromInt64(100, Z), "25%"},
+		{FromInt64(100, Z), FromInt64(80, Z), "-20%"},
+		{FromInt64(-50, Z), FromInt64(-25, Z), "50%"},
+		{FromInt64(3, Z), FromInt64(4, Z), "33.33%"},
+	} {
+		if got := must(PercentChange(tc.from, tc.to, Centi, HalfEven)).String(); got != tc.expected {
+			t.Fatalf("invalid change from %s to %s, expected %s, got %s", tc.from, tc.to, tc.expected, got)
+		}
+	}
+	if _, err := PercentChange(Zero(Z), One(Z), Centi, HalfEven); !errors.Is(err, ErrDivisionByZero) {
+		t.Fatalf("expected ErrDivisionByZero, got %v", err)
+	}
+}
+
+func Test_CAGR(t *testing.T) {
+	for _, tc := range []struct {
+		begin, end Decimal
+		periods    int64
+		p          Precision
+		m          RoundingMode
+		expected   string
+	}{
+		{FromInt64(100, Z), FromInt64(200, Z), 5, Centi, HalfEven, "14.87%"},
+		{FromInt64(100, Z), FromInt64(200, Z), 5, Nano, HalfEven, "14.8698355%"},
+		{FromInt64(100, Z), FromInt64(50, Z), 3, Centi, ToZero, "-20.62%"},
+		{FromInt64(100, Z), FromInt64(50, Z), 3, Centi, AwayFromZero, "-20.63%"},
+		{FromInt64(100, Z), FromInt64(50, Z), 3, Centi, Floor, "-20.63%"},
+		{FromInt64(100, Z), FromInt64(121, Z), 2, Centi, HalfEven, "10%"},
+		{FromInt64(3, Z), FromInt64(7, Z), 1, Centi, HalfEven, "133.33%"},
+		{FromInt64(-100, Z), FromInt64(-121, Z), 2, Centi, HalfEven, "10%"},
+		{FromInt64(100, Z), Zero(Z), 4, Centi, HalfEven, "-100%"},
+		// (1.0025)^2 = 1.00500625 and (1.002505)^2 = 1.005016275025 is a tie.
+		{Z.FromInt64(1), Milli.MustParse("1.00500625"), 2, Centi, HalfEven, "0.25%"},
+		{Z.FromInt64(1), MustParse("1.005016275025", 12, false), 2, Milli, HalfEven, "0.25%"},
+		{Z.FromInt64(1), MustParse("1.005016275025", 12, false), 2, Milli, HalfUp, "0.251%"},
+	} {
+		if got := must(CAGR(tc.begin, tc.end, tc.periods, tc.p, tc.m)).String(); got != tc.expected {
+			t.Fatalf("invalid CAGR from %s to %s in %d periods, expected %s, got %s", tc.begin, tc.end, tc.periods, tc.expected, got)
+		}
+	}
+	// 5462 * (9+3) digits wrap around uint16.
+	if _, err := CAGR(One(Z), Ten(Z), 5462, Nano, HalfEven); !errors.Is(err, ErrTooManyPeriods) {
+		t.Fatalf("expected ErrTooManyPeriods, got %v", err)
+	}
+	for _, tc := range []struct {
+		begin, end Decimal
+		periods    int64
+		expected   error
+	}{
+		{One(Z), One(Z), 0, ErrInvalidPeriods},
+		{Zero(Z), One(Z), 1, ErrDivisionByZero},
+		{One(Z), One(Z).Neg(), 1, ErrInvalidGrowth},
+		{One(Z), Ten(Z), 13108, ErrTooManyPeriods},
+		{One(Z), Ten(Z), 1 << 40, ErrTooManyPeriods},
+	} {
+		if _, err := CAGR(tc.begin, tc.end, tc.periods, Centi, HalfEven); !errors.Is(err, tc.expected) {
+			t.Fatalf("expected %v, got %v", tc.expected, err)
+		}
+	}
+}
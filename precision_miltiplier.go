@@ -1,6 +1,7 @@
 package dec
 
 import (
+	"math"
 	"math/big"
 	"sync"
 )
@@ -74,3 +75,11 @@ func (p Precision) multiplierOnlyForReadIPromise() *big.Int {
 	multiplierCache.l.Unlock()
 	return value
 }
+
+// pow10 returns 10^n for a non-negative n, the result must not be modified.
+func pow10(n int64) *big.Int {
+	if n <= math.MaxUint16 {
+		return Precision(n).multiplierOnlyForReadIPromise()
+	}
+	return (&big.Int{}).Exp(big.NewInt(BASE), big.NewInt(n), nil)
+}
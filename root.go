@@ -0,0 +1,106 @@
+package dec
+
+import (
+	"math/big"
+
+	"github.com/pr0n1x/go-liners/werr"
+)
+
+var (
+	ErrNegativeEvenRoot = werr.New("even root of a negative decimal")
+	ErrInvalidRootIndex = werr.New("root index should be positive")
+)
+
+// Sqrt sets d to the square root of d correctly rounded to the resultPrecision using the mode m.
+func (d *DecimalMut) Sqrt(resultPrecision Precision, m RoundingMode) (*DecimalMut, error) {
+	return d.NthRoot(2, resultPrecision, m)
+}
+
+// NthRoot sets d to the n-th root of d correctly rounded to the resultPrecision using the mode m.
+// It returns ErrNegativeEvenRoot for a negative d and an even n, d stays unchanged on error.
+func (d *DecimalMut) NthRoot(n int64, resultPrecision Precision, m RoundingMode) (*DecimalMut, error) {
+	if err := d.checkNil(); err != nil {
+		return nil, err
+	}
+	if !m.valid() {
+		panic("invalid rounding mode")
+	}
+	if n < 1 {
+		return nil, ErrInvalidRootIndex
+	}
+	sign := d.val.Sign()
+	if sign < 0 && n%2 == 0 {
+		return nil, ErrNegativeEvenRoot
+	}
+	// the root is computed with k extra digits which are rounded off at the end,
+	// k is chosen to make the radicand a whole number: |val| * 10^(n*(p+k) - exp).
+	p, exp := int64(resultPrecision), int64(d.exp)
+	k := int64(1)
+	if n*(p+k) < exp {
+		k = (exp+n-1)/n - p
+	}
+	radicand := (&big.Int{}).Abs(&d.val)
+	radicand.Mul(radicand, pow10(n*(p+k)-exp))
+
+	root := &d.val
+	if exact := intRoot(root, radicand, n); !exact {
+		appendSticky(root, 1)
+		k++
+	}
+	if sign < 0 {
+		root.Neg(root)
+	}
+	quoRound(root, root, pow10(k), m)
+	d.exp = resultPrecision
+	return d, nil
+}
+
+// appendSticky appends a sticky digit to the truncated units of an inexact value of the sign:
+// it keeps the position against the half but makes the tail non-zero,
+// so the units round to fewer digits the same way as the exact value.
+func appendSticky(units *big.Int, sign int) *big.Int {
+	units.Mul(units, deciMultiplier)
+	return units.Add(units, big.NewInt(int64(sign)))
+}
+
+// intRoot sets z to the floor of the n-th root of a non-negative x and reports whether the root is exact.
+func intRoot(z, x *big.Int, n int64) (exact bool) {
+	if x.Sign() == 0 || n == 1 {
+		z.Set(x)
+		return true
+	}
+	if n == 2 {
+		z.Sqrt(x)
+	} else {
+		// Newton's iterations starting from the power of two which is not less than the root.
+		bigN := big.NewInt(n)
+		bigN1 := big.NewInt(n - 1)
+		cur := (&big.Int{}).Lsh(big.NewInt(1), uint((int64(x.BitLen())+n-1)/n))
+		next, power := &big.Int{}, &big.Int{}
+		for {
+			// next = ((n-1)*cur + x/cur^(n-1)) / n.
+			power.Exp(cur, bigN1, nil)
+			power.Quo(x, power)
+			next.Mul(cur, bigN1).Add(next, power).Quo(next, bigN)
+			if next.Cmp(cur) >= 0 {
+				break
+			}
+			cur, next = next, cur
+		}
+		z.Set(cur)
+	}
+	check := (&big.Int{}).Exp(z, big.NewInt(n), nil)
+	return check.Cmp(x) == 0
+}
+
+func (d Decimal) Sqrt(resultPrecision Precision, m RoundingMode) (Decimal, error) {
+	return d.NthRoot(2, resultPrecision, m)
+}
+
+func (d Decimal) NthRoot(n int64, resultPrecision Precision, m RoundingMode) (Decimal, error) {
+	root, err := d.lhs().NthRoot(n, resultPrecision, m)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return root.Val(), nil
+}
@@ -0,0 +1,101 @@
+package dec
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func Test_Sqrt(t *testing.T) {
+	for _, tc := range []roundTestCase{
+		{n: Z.FromUInt64(2), r: Nano, e: "1.414213562"},
+		{n: Z.FromUInt64(2), r: Quecto, e: "1.41421356237309504880168872421"},
+		{n: Z.FromUInt64(2), r: 50, e: "1.41421356237309504880168872420969807856967187537695"},
+		{n: Centi.MustParse("6.25"), r: Centi, e: "2.5"},
+		{n: Centi.MustParse("6.25"), r: Z, e: "2"},
+		{n: Nano.MustParse("0.000000001"), r: Nano, e: "0.000031623"},
+		{n: Atto.MustParse("123456789.123456789"), r: Micro, e: "11111.111066"},
+		{n: Nano.Zero(), r: Milli, e: "0"},
+	} {
+		res, err := tc.n.Sqrt(tc.r, HalfEven)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, expected := res.String(), tc.e; got != expected {
+			t.Fatalf("invalid Sqrt of %s, expected %s, got %s", tc.n, expected, got)
+		}
+		if got, expected := res.Precision(), tc.r; got != expected {
+			t.Fatalf("invalid Sqrt result precision, expected %d, got %d", expected, got)
+		}
+	}
+}
+
+func Test_SqrtRoundingModes(t *testing.T) {
+	for _, tc := range []struct {
+		m RoundingMode
+		e string
+	}{
+		{m: HalfEven, e: "2"},
+		{m: HalfUp, e: "3"},
+		{m: HalfDown, e: "2"},
+		{m: HalfOdd, e: "3"},
+		{m: ToZero, e: "2"},
+		{m: AwayFromZero, e: "3"},
+	} {
+		// sqrt(6.25) == 2.5 is an exact tie.
+		if got, expected := must(Centi.MustParse("6.25").Sqrt(Z, tc.m)).String(), tc.e; got != expected {
+			t.Fatalf("invalid Sqrt with mode %d, expected %s, got %s", tc.m, expected, got)
+		}
+	}
+	// sqrt(6.2500001) is slightly above the tie.
+	if got, expected := must(Nano.MustParse("6.2500001").Sqrt(Z, HalfEven)).String(), "3"; got != expected {
+		t.Fatalf("invalid Sqrt above the half, expected %s, got %s", expected, got)
+	}
+	// sqrt(2) == 1.41421356237... the discarded digits are far below the half.
+	if got, expected := must(Z.FromUInt64(2).Sqrt(Centi, AwayFromZero)).String(), "1.42"; got != expected {
+		t.Fatalf("invalid Sqrt, expected %s, got %s", expected, got)
+	}
+	if got, expected := must(Z.FromUInt64(4).Sqrt(Centi, AwayFromZero)).String(), "2"; got != expected {
+		t.Fatalf("invalid Sqrt of an exact square, expected %s, got %s", expected, got)
+	}
+}
+
+func Test_NthRoot(t *testing.T) {
+	for _, tc := range []struct {
+		n Decimal
+		i int64
+		r Precision
+		m RoundingMode
+		e string
+	}{
+		{n: Z.FromInt64(27), i: 3, r: Nano, m: HalfEven, e: "3"},
+		{n: Z.FromInt64(-27), i: 3, r: Nano, m: HalfEven, e: "-3"},
+		{n: Z.FromUInt64(2), i: 3, r: Nano, m: HalfEven, e: "1.25992105"},
+		{n: Z.FromInt64(-2), i: 3, r: Nano, m: ToNegativeInf, e: "-1.25992105"},
+		{n: Z.FromInt64(-2), i: 3, r: Nano, m: ToZero, e: "-1.259921049"},
+		{n: Centi.MustParse("1.05"), i: 12, r: Atto, m: HalfEven, e: "1.004074123783648302"},
+		{n: Micro.MustParse("1.5"), i: 1, r: Z, m: HalfEven, e: "2"},
+		{n: Z.FromUInt64(1024), i: 10, r: Quecto, m: HalfEven, e: "2"},
+	} {
+		res, err := tc.n.NthRoot(tc.i, tc.r, tc.m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, expected := res.String(), tc.e; got != expected {
+			t.Fatalf("invalid %d-th root of %s, expected %s, got %s", tc.i, tc.n, expected, got)
+		}
+	}
+}
+
+func Test_RootErrors(t *testing.T) {
+	n := Nano.FromInt64(-4)
+	if _, err := n.Sqrt(Nano, HalfEven); !errors.Is(err, ErrNegativeEvenRoot) {
+		t.Fatalf("expected ErrNegativeEvenRoot, got %v", err)
+	}
+	if _, err := n.NthRoot(0, Nano, HalfEven); !errors.Is(err, ErrInvalidRootIndex) {
+		t.Fatalf("expected ErrInvalidRootIndex, got %v", err)
+	}
+	if got := n.String(); !strings.HasPrefix(got, "-4") {
+		t.Fatalf("operand should stay unchanged, got %s", got)
+	}
+}
@@ -1,58 +1,121 @@
 package dec
 
+import "math/big"
+
 type RoundingMode uint8
 
 const (
-	HalfEven     RoundingMode = iota // == IEEE 754-2008 roundTiesToEven.
-	HalfUp                           // == IEEE 754-2008 roundTiesToAway.
-	HalfDown                         // no IEEE 754-2008 equivalent.
-	ToZero                           // == IEEE 754-2008 roundTowardZero.
-	AwayFromZero                     // no IEEE 754-2008 equivalent.
-	// TODO: Implement other rounding modes
-
-	//ToPositiveInf                     // == IEEE 754-2008 roundTowardPositive.
+	HalfEven       RoundingMode = iota // == IEEE 754-2008 roundTiesToEven.
+	HalfUp                             // == IEEE 754-2008 roundTiesToAway.
+	HalfDown                           // no IEEE 754-2008 equivalent.
+	ToZero                             // == IEEE 754-2008 roundTowardZero.
+	AwayFromZero                       // no IEEE 754-2008 equivalent.
+	ToPositiveInf                      // == IEEE 754-2008 roundTowardPositive.
+	ToNegativeInf                      // == IEEE 754-2008 roundTowardNegative.
+	HalfOdd                            // no IEEE 754-2008 equivalent.
+	HalfTowardZero                     // no IEEE 754-2008 equivalent.
+	ZeroFiveUp                         // == General Decimal Arithmetic ROUND_05UP.
+)
+
+const (
+	Ceiling = ToPositiveInf
+	Floor   = ToNegativeInf
 )
 
+func (m RoundingMode) valid() bool {
+	return m <= ZeroFiveUp
+}
+
 func (d *DecimalMut) Round(r Precision, m RoundingMode) *DecimalMut {
-	switch m {
-	case HalfEven, HalfUp, HalfDown, ToZero, AwayFromZero:
-	default:
+	if !m.valid() {
 		panic("invalid rounding mode")
 	}
-	sign := d.Val().Sign()
-	if d.exp <= r || sign == 0 {
+	if d.exp <= r || d.val.Sign() == 0 {
 		return d
 	}
-	rounding := d.Copy()
-	remainder := rounding.RescaleRem(r)
-	unit := r.Unit()
+	quoRound(&d.val, &d.val, (d.exp - r).multiplierOnlyForReadIPromise(), m)
+	d.exp = r
+	return d
+}
+
+// roundTo rounds d to p digits using the mode m or rescales it up to p digits.
+func roundTo(d Decimal, p Precision, m RoundingMode) Decimal {
+	return d.Round(p, m).Rescale(p)
+}
+
+// Ceil rounds d toward positive infinity to a whole number keeping the precision of d.
+func (d *DecimalMut) Ceil() *DecimalMut {
+	return d.roundWhole(ToPositiveInf)
+}
+
+// Floor rounds d toward negative infinity to a whole number keeping the precision of d.
+func (d *DecimalMut) Floor() *DecimalMut {
+	return d.roundWhole(ToNegativeInf)
+}
+
+// Trunc drops the fractional part of d keeping the precision of d.
+func (d *DecimalMut) Trunc() *DecimalMut {
+	return d.roundWhole(ToZero)
+}
+
+func (d *DecimalMut) roundWhole(m RoundingMode) *DecimalMut {
+	exp := d.exp
+	return d.Round(Z, m).Rescale(exp)
+}
+
+// quoRound sets z to the quotient x/y rounded using the rounding mode m
+// and reports whether the division was exact. y must not be zero.
+func quoRound(z, x, y *big.Int, m RoundingMode) (exact bool) {
+	sign := x.Sign() * y.Sign()
+	rem := big.Int{}
+	z.QuoRem(x, y, &rem)
+	if rem.Sign() == 0 {
+		return true
+	}
+	if roundAwayFromZero(z, &rem, y, sign, m) {
+		one := big.Int{} // on stack
+		one.SetInt64(int64(sign))
+		z.Add(z, &one)
+	}
+	return false
+}
+
+// roundAwayFromZero reports whether a truncated quotient q must be moved one unit away from zero
+// depending on the non-zero remainder rem of the division by y and the sign of the exact quotient.
+func roundAwayFromZero(q, rem, y *big.Int, sign int, m RoundingMode) bool {
 	switch m {
-	case HalfEven, HalfUp, HalfDown:
-		half := Unit(r + 1).Mul(FromUInt64(5, 0))
-		if sign < 0 {
-			half.Var().Neg()
-		}
-		halfDeflection := remainder.Cmp(half)
-		switch {
-		case halfDeflection == 0 && m == HalfEven:
-			if rounding.Copy().Mod(FromUInt64(2, 0)).Val().Int64() != 0 {
-				rounding.Add(unit)
-			}
-		case sign > 0 && ((halfDeflection == 0 && m == HalfUp) || halfDeflection > 0):
-			rounding.Add(unit)
-		case sign < 0 && ((halfDeflection == 0 && m == HalfDown) || halfDeflection < 0):
-			rounding.Sub(unit)
-		}
-	case ToZero, AwayFromZero:
-		if remainder.Var().Abs().Val().Cmp(Zero(0)) > 0 && m == AwayFromZero {
-			if sign > 0 {
-				rounding.Add(unit)
-			} else {
-				rounding.Sub(unit)
-			}
-		}
+	case ToZero:
+		return false
+	case AwayFromZero:
+		return true
+	case ToPositiveInf:
+		return sign > 0
+	case ToNegativeInf:
+		return sign < 0
+	case ZeroFiveUp:
+		lastDigit := big.Int{}
+		lastDigit.Abs(q).Rem(&lastDigit, deciMultiplier)
+		return lastDigit.Sign() == 0 || lastDigit.Uint64() == 5
 	}
-	d.val = rounding.val
-	d.exp = rounding.exp
-	return d
+
+	// compare the doubled remainder with the divisor to find out the deflection from the half.
+	doubled := big.Int{}
+	doubled.Abs(rem).Lsh(&doubled, 1)
+	halfDeflection := doubled.CmpAbs(y)
+	if halfDeflection != 0 {
+		return halfDeflection > 0
+	}
+	switch m {
+	case HalfEven:
+		return q.Bit(0) != 0
+	case HalfOdd:
+		return q.Bit(0) == 0
+	case HalfUp:
+		return sign > 0
+	case HalfDown:
+		return sign < 0
+	case HalfTowardZero:
+		return false
+	}
+	panic("invalid rounding mode")
 }
@@ -116,3 +116,72 @@ func Test_RoundModeAwayFromZero(t *testing.T) {
 		}
 	}
 }
+
+func Test_RoundModesTable(t *testing.T) {
+	// the same table as in the General Decimal Arithmetic specification.
+	numbers := []int64{55, 25, 16, 11, 10, -10, -11, -16, -25, -55, -35, 5, -5}
+	for _, tc := range []struct {
+		m RoundingMode
+		e []string
+	}{
+		{m: HalfEven, e: []string{"6", "2", "2", "1", "1", "-1", "-1", "-2", "-2", "-6", "-4", "0", "0"}},
+		{m: HalfUp, e: []string{"6", "3", "2", "1", "1", "-1", "-1", "-2", "-2", "-5", "-3", "1", "0"}},
+		{m: HalfDown, e: []string{"5", "2", "2", "1", "1", "-1", "-1", "-2", "-3", "-6", "-4", "0", "-1"}},
+		{m: ToZero, e: []string{"5", "2", "1", "1", "1", "-1", "-1", "-1", "-2", "-5", "-3", "0", "0"}},
+		{m: AwayFromZero, e: []string{"6", "3", "2", "2", "1", "-1", "-2", "-2", "-3", "-6", "-4", "1", "-1"}},
+		{m: ToPositiveInf, e: []string{"6", "3", "2", "2", "1", "-1", "-1", "-1", "-2", "-5", "-3", "1", "0"}},
+		{m: ToNegativeInf, e: []string{"5", "2", "1", "1", "1", "-1", "-2", "-2", "-3", "-6", "-4", "0", "-1"}},
+		{m: HalfOdd, e: []string{"5", "3", "2", "1", "1", "-1", "-1", "-2", "-3", "-5", "-3", "1", "-1"}},
+		{m: HalfTowardZero, e: []string{"5", "2", "2", "1", "1", "-1", "-1", "-2", "-2", "-5", "-3", "0", "0"}},
+		{m: ZeroFiveUp, e: []string{"6", "2", "1", "1", "1", "-1", "-1", "-1", "-2", "-6", "-3", "1", "-1"}},
+	} {
+		for i, n := range numbers {
+			if got, expected := Deci.FromUnitsInt64(n).Round(Z, tc.m).String(), tc.e[i]; got != expected {
+				t.Fatalf("invalid Round of %d units with mode %d, expected %s, got %s", n, tc.m, expected, got)
+			}
+		}
+	}
+}
+
+func Test_RoundZeroFiveUp(t *testing.T) {
+	for _, tc := range []roundTestCase{
+		{n: Milli.MustParse("1.051"), r: 2, e: "1.06"},
+		{n: Milli.MustParse("1.041"), r: 2, e: "1.04"},
+		{n: Milli.MustParse("1.001"), r: 2, e: "1.01"},
+		{n: Milli.MustParse("-1.001"), r: 2, e: "-1.01"},
+		{n: Milli.MustParse("-1.061"), r: 2, e: "-1.06"},
+		{n: Milli.MustParse("1.05"), r: 2, e: "1.05"},
+	} {
+		if got, expected := tc.n.Round(tc.r, ZeroFiveUp).String(), tc.e; got != expected {
+			t.Fatalf("invalid Round, expected %s, got %s", expected, got)
+		}
+	}
+}
+
+func Test_CeilFloorTrunc(t *testing.T) {
+	for _, tc := range []struct {
+		n     Decimal
+		ceil  string
+		floor string
+		trunc string
+	}{
+		{n: Nano.MustParse("1.000000001"), ceil: "2", floor: "1", trunc: "1"},
+		{n: Nano.MustParse("-1.000000001"), ceil: "-1", floor: "-2", trunc: "-1"},
+		{n: Deci.FromUnitsInt64(-5), ceil: "0", floor: "-1", trunc: "0"},
+		{n: Nano.MustParse("3"), ceil: "3", floor: "3", trunc: "3"},
+		{n: Z.FromInt64(-7), ceil: "-7", floor: "-7", trunc: "-7"},
+	} {
+		if got, expected := tc.n.Ceil().String(), tc.ceil; got != expected {
+			t.Fatalf("invalid Ceil of %s, expected %s, got %s", tc.n, expected, got)
+		}
+		if got, expected := tc.n.Floor().String(), tc.floor; got != expected {
+			t.Fatalf("invalid Floor of %s, expected %s, got %s", tc.n, expected, got)
+		}
+		if got, expected := tc.n.Trunc().String(), tc.trunc; got != expected {
+			t.Fatalf("invalid Trunc of %s, expected %s, got %s", tc.n, expected, got)
+		}
+		if got, expected := tc.n.Floor().Precision(), tc.n.Precision(); got != expected {
+			t.Fatalf("Floor should keep the precision, expected %d, got %d", expected, got)
+		}
+	}
+}
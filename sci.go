@@ -0,0 +1,67 @@
+package dec
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ParseSci parses a decimal number in the plain or the exponent notation: "1.5e-7", "2E+3", "-3.0e2".
+// The syntax is the one of Parse with the exponent, see ParseOptions.AllowExponent.
+// If the number has more fractional digits than the precision they're rounded off using
+// the optional rounding mode (ToZero by default) when limitPrecision is set,
+// otherwise the precision is extended to keep all the digits.
+func ParseSci(val string, precision Precision, limitPrecision bool, m ...RoundingMode) (Decimal, error) {
+	mode := ToZero
+	if len(m) > 0 {
+		mode = m[0]
+	}
+	if !mode.valid() {
+		panic("invalid rounding mode")
+	}
+	return sciParsing.parse(val, precision, limitPrecision, mode)
+}
+
+// MustParseSci the same as ParseSci but panics on error.
+func MustParseSci(val string, precision Precision, limitPrecision bool, m ...RoundingMode) Decimal {
+	return must(ParseSci(val, precision, limitPrecision, m...))
+}
+
+// StringSci returns d in the scientific notation with all the significant digits: "1.5e-7", "2e+3".
+func (d Decimal) StringSci() string {
+	digs, dp := d.digits()
+	return d.stringExp(digs, dp-1, 1)
+}
+
+// StringEng returns d in the engineering notation where the exponent is a multiple of three: "150e-9", "12.345e+3".
+func (d Decimal) StringEng() string {
+	digs, dp := d.digits()
+	exp := dp - 1
+	// floor the exponent to a multiple of three.
+	exp -= ((exp % 3) + 3) % 3
+	return d.stringExp(digs, exp, dp-exp)
+}
+
+// stringExp formats the significant digits with intDigits digits before the point and the exponent.
+func (d Decimal) stringExp(digs []byte, exp, intDigits int) string {
+	var b strings.Builder
+	if d.Sign() < 0 {
+		b.WriteByte('-')
+	}
+	if len(digs) == 0 {
+		return "0e+0"
+	}
+	if len(digs) < intDigits {
+		digs = append(digs, strings.Repeat("0", intDigits-len(digs))...)
+	}
+	b.Write(digs[:intDigits])
+	if len(digs) > intDigits {
+		b.WriteByte('.')
+		b.Write(digs[intDigits:])
+	}
+	b.WriteByte('e')
+	if exp >= 0 {
+		b.WriteByte('+')
+	}
+	b.WriteString(strconv.Itoa(exp))
+	return b.String()
+}
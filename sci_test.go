@@ -0,0 +1,74 @@
+package dec
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_ParseSci(t *testing.T) {
+	for _, tc := range []struct {
+		input     string
+		precision Precision
+		limit     bool
+		m         []RoundingMode
+		expected  string
+		resultP   Precision
+	}{
+		{"1.5e-7", Nano, true, nil, "0.00000015", Nano},
+		{"1.5e-7", Z, false, nil, "0.00000015", 8},
+		{"2E+3", Centi, true, nil, "2000", Centi},
+		{"-3.0e2", Z, true, nil, "-300", Z},
+		{"-0.5", Centi, true, nil, "-0.5", Centi},
+		{"+12.345", Centi, true, nil, "12.34", Centi},
+		{"12.345", Centi, true, []RoundingMode{HalfUp}, "12.35", Centi},
+		{"-12.345", Centi, true, []RoundingMode{Floor}, "-12.35", Centi},
+		{"12.345", Centi, false, nil, "12.345", Milli},
+		{"1.23456789e3", Centi, true, []RoundingMode{HalfEven}, "1234.57", Centi},
+		{"5e-3", Centi, true, []RoundingMode{HalfEven}, "0", Centi},
+		{"5e-3", Centi, true, []RoundingMode{HalfUp}, "0.01", Centi},
+		{"123", Z, true, nil, "123", Z},
+		{"0.5e1", Z, true, nil, "5", Z},
+		{"0e10", Milli, true, nil, "0", Milli},
+	} {
+		d, err := ParseSci(tc.input, tc.precision, tc.limit, tc.m...)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", tc.input, err)
+		}
+		if got := d.String(); got != tc.expected || d.Precision() != tc.resultP {
+			t.Fatalf("invalid value of %q, expected %s with precision %d, got %s with precision %d",
+				tc.input, tc.expected, tc.resultP, got, d.Precision())
+		}
+	}
+	for _, input := range []string{"", "e5", "1e", "1e+", "1.5e-7e1", "1.-5", "--1", "1,5", "0x10", "1e99999", " 1", ".5", "1.", "+.5e1"} {
+		var pe *ParseError
+		if _, err := ParseSci(input, Centi, true); !errors.Is(err, ErrInvalidDecimalString) || !errors.As(err, &pe) {
+			t.Fatalf("expected *ParseError for %q, got %v", input, err)
+		}
+	}
+}
+
+func Test_StringSci(t *testing.T) {
+	for _, tc := range []struct {
+		d        Decimal
+		sci, eng string
+	}{
+		{MustParseSci("1.5e-7", Z, false), "1.5e-7", "150e-9"},
+		{FromInt64(2000, Centi), "2e+3", "2e+3"},
+		{FromInt64(-300, Z), "-3e+2", "-300e+0"},
+		{Milli.MustParse("12345.678"), "1.2345678e+4", "12.345678e+3"},
+		{MustParseSci("1e-3", Z, false), "1e-3", "1e-3"},
+		{MustParseSci("1e-4", Z, false), "1e-4", "100e-6"},
+		{Centi.MustParse("1.5"), "1.5e+0", "1.5e+0"},
+		{Zero(Nano), "0e+0", "0e+0"},
+	} {
+		if got := tc.d.StringSci(); got != tc.sci {
+			t.Fatalf("invalid scientific notation of %s, expected %s, got %s", tc.d, tc.sci, got)
+		}
+		if got := tc.d.StringEng(); got != tc.eng {
+			t.Fatalf("invalid engineering notation of %s, expected %s, got %s", tc.d, tc.eng, got)
+		}
+		if back := MustParseSci(tc.d.StringSci(), Z, false); back.Cmp(tc.d) != 0 {
+			t.Fatalf("round trip of %s failed, got %s", tc.d, back)
+		}
+	}
+}
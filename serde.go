@@ -41,7 +41,8 @@ func unmarshalText[T typePrecisionWrapper](d *T, data []byte) error {
 		return errors.New("invalid decimal number")
 	}
 
-	coins, err := Parse(string(data), (*d).TypePrecision(), true)
+	// the syntax of Parse with the exponent.
+	coins, err := sciParsing.Parse(string(data), (*d).TypePrecision(), true)
 	if err != nil {
 		return err
 	}
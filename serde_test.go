@@ -52,3 +52,25 @@ func Test_Decode(t *testing.T) {
 		t.Error("unmarshal failed")
 	}
 }
+
+func Test_DecodeSci(t *testing.T) {
+	var values twoDecValuesJson
+	if err := json.Unmarshal([]byte(`{"a": "1.5e-7","b": "-2.5E-1"}`), &values); err != nil {
+		t.Fatal(err)
+	}
+	if got := values.A.GetDecimal().String(); got != "0.00000015" {
+		t.Fatalf("unmarshal failed, got %s", got)
+	}
+	if got := values.B.GetDecimal().String(); got != "-0.25" {
+		t.Fatalf("unmarshal failed, got %s", got)
+	}
+}
+
+func Test_DecodeSyntax(t *testing.T) {
+	for _, input := range []string{`".5"`, `"1."`, `"+.5"`, `" 1"`, `"1_000"`, `"1e"`} {
+		var value TextCenti
+		if err := json.Unmarshal([]byte(input), &value); !errors.Is(err, ErrInvalidDecimalString) {
+			t.Fatalf("expected ErrInvalidDecimalString for %s, got %v", input, err)
+		}
+	}
+}
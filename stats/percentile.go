@@ -0,0 +1,56 @@
+package stats
+
+import (
+	dec "github.com/pr0n1x/decimal-go"
+)
+
+// Interpolation is a method to compute a percentile lying between two values, see numpy.percentile.
+type Interpolation uint8
+
+const (
+	Linear   Interpolation = iota // lo + (hi - lo) * fraction, the same as Excel PERCENTILE.INC.
+	Lower                         // lo.
+	Higher                        // hi.
+	Nearest                       // lo or hi, whichever is nearest, the ties go to the even index.
+	Midpoint                      // (lo + hi) / 2.
+)
+
+var hundred = dec.FromUInt64(100, dec.Z)
+
+// Percentile returns the q-th percentile of the values, q is in range [0, 100].
+func Percentile(values []dec.Decimal, q dec.Decimal, method Interpolation, p dec.Precision, m dec.RoundingMode) (dec.Decimal, error) {
+	if len(values) == 0 {
+		return dec.Decimal{}, ErrEmpty
+	}
+	if q.Sign() < 0 || q.Cmp(hundred) > 0 {
+		return dec.Decimal{}, ErrInvalidPercentile
+	}
+	s := sorted(values)
+	// position of the percentile in the sorted values: (n-1) * q / 100, it's exact with 2 extra digits.
+	pos := q.MulRound(dec.FromInt64(int64(len(s)-1), dec.Z), q.Precision(), dec.ToZero).
+		QuoRound(hundred, q.Precision()+2, dec.ToZero)
+	index := pos.Trunc().Int64()
+	fraction := pos.Sub(pos.Trunc())
+	lo, hi := s[index], s[index]
+	if fraction.Sign() != 0 {
+		hi = s[index+1]
+	}
+
+	switch method {
+	case Lower:
+		return roundTo(lo, p, m), nil
+	case Higher:
+		return roundTo(hi, p, m), nil
+	case Nearest:
+		if pos.Round(dec.Z, dec.HalfEven).Int64() == index {
+			return roundTo(lo, p, m), nil
+		}
+		return roundTo(hi, p, m), nil
+	case Midpoint:
+		return lo.Add(hi).QuoRound(dec.FromUInt64(2, dec.Z), p, m), nil
+	case Linear:
+		delta := hi.Sub(lo)
+		return roundTo(lo.Add(delta.MulRound(fraction, delta.Precision()+fraction.Precision(), dec.ToZero)), p, m), nil
+	}
+	panic("invalid percentile interpolation method")
+}
@@ -0,0 +1,203 @@
+// Package stats computes descriptive statistics of decimal values
+// rounding every result only once to the requested precision.
+package stats
+
+import (
+	"math"
+	"slices"
+
+	dec "github.com/pr0n1x/decimal-go"
+	"github.com/pr0n1x/go-liners/werr"
+)
+
+var (
+	ErrEmpty             = werr.New("empty list of values")
+	ErrNotEnoughValues   = werr.New("sample statistics require at least two values")
+	ErrZeroDeviation     = werr.New("standard deviation is zero")
+	ErrInvalidPercentile = werr.New("percentile should be in range [0, 100]")
+	ErrTooManyDigits     = werr.New("squares of the values exceed the maximum precision")
+)
+
+// Mean returns the arithmetic mean of the values.
+func Mean(values []dec.Decimal, p dec.Precision, m dec.RoundingMode) (dec.Decimal, error) {
+	if len(values) == 0 {
+		return dec.Decimal{}, ErrEmpty
+	}
+	return dec.Avg(p, m, values[0], values[1:]...), nil
+}
+
+// Median returns the middle value of the sorted values or the mean of the two middle values.
+func Median(values []dec.Decimal, p dec.Precision, m dec.RoundingMode) (dec.Decimal, error) {
+	return Percentile(values, dec.FromUInt64(50, dec.Z), Midpoint, p, m)
+}
+
+// Mode returns the most frequent values in ascending order, the values are rounded to p before counting.
+func Mode(values []dec.Decimal, p dec.Precision, m dec.RoundingMode) ([]dec.Decimal, error) {
+	if len(values) == 0 {
+		return nil, ErrEmpty
+	}
+	rounded := make([]dec.Decimal, len(values))
+	for i, v := range values {
+		rounded[i] = roundTo(v, p, m)
+	}
+	slices.SortFunc(rounded, dec.Decimal.Cmp)
+
+	var modes []dec.Decimal
+	maxCount := 0
+	for start := 0; start < len(rounded); {
+		end := start + 1
+		for end < len(rounded) && rounded[end].Cmp(rounded[start]) == 0 {
+			end++
+		}
+		switch count := end - start; {
+		case count > maxCount:
+			maxCount = count
+			modes = append(modes[:0], rounded[start])
+		case count == maxCount:
+			modes = append(modes, rounded[start])
+		}
+		start = end
+	}
+	return modes, nil
+}
+
+// Variance returns the population variance of the values.
+func Variance(values []dec.Decimal, p dec.Precision, m dec.RoundingMode) (dec.Decimal, error) {
+	s, n, err := squaresDeviation(values, 1)
+	if err != nil {
+		return dec.Decimal{}, err
+	}
+	return s.QuoRound(dec.FromInt64(n*n, dec.Z), p, m), nil
+}
+
+// SampleVariance returns the sample (Bessel's corrected) variance of the values.
+func SampleVariance(values []dec.Decimal, p dec.Precision, m dec.RoundingMode) (dec.Decimal, error) {
+	s, n, err := squaresDeviation(values, 2)
+	if err != nil {
+		return dec.Decimal{}, err
+	}
+	return s.QuoRound(dec.FromInt64(n*(n-1), dec.Z), p, m), nil
+}
+
+// StdDev returns the population standard deviation of the values.
+func StdDev(values []dec.Decimal, p dec.Precision, m dec.RoundingMode) (dec.Decimal, error) {
+	s, n, err := squaresDeviation(values, 1)
+	if err != nil {
+		return dec.Decimal{}, err
+	}
+	return sqrtQuo(s, dec.FromInt64(n*n, dec.Z), p, m)
+}
+
+// SampleStdDev returns the sample standard deviation of the values.
+func SampleStdDev(values []dec.Decimal, p dec.Precision, m dec.RoundingMode) (dec.Decimal, error) {
+	s, n, err := squaresDeviation(values, 2)
+	if err != nil {
+		return dec.Decimal{}, err
+	}
+	return sqrtQuo(s, dec.FromInt64(n*(n-1), dec.Z), p, m)
+}
+
+// ZScores returns the standard scores (x - mean) / stddev of the values using the population standard deviation.
+func ZScores(values []dec.Decimal, p dec.Precision, m dec.RoundingMode) ([]dec.Decimal, error) {
+	s, n, err := squaresDeviation(values, 1)
+	if err != nil {
+		return nil, err
+	}
+	if s.Sign() == 0 {
+		return nil, ErrZeroDeviation
+	}
+	// (x - sum/n) / (sqrt(s)/n) == (n*x - sum) / sqrt(s) == sign * sqrt((n*x - sum)^2 / s).
+	sum := dec.Sum(values[0], values[1:]...)
+	count := dec.FromInt64(n, dec.Z)
+	scores := make([]dec.Decimal, len(values))
+	for i, x := range values {
+		deviation := x.MulRound(count, x.Precision(), dec.ToZero).Sub(sum)
+		squared := deviation.MulRound(deviation, s.Precision(), dec.ToZero)
+		if deviation.Sign() >= 0 {
+			scores[i], err = sqrtQuo(squared, s, p, m)
+		} else {
+			scores[i], err = sqrtQuo(squared, s, p, mirror(m))
+			scores[i] = scores[i].Neg()
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return scores, nil
+}
+
+// squaresDeviation returns the exact n*sum(x^2) - sum(x)^2 and the number of values.
+func squaresDeviation(values []dec.Decimal, minCount int) (dec.Decimal, int64, error) {
+	if len(values) == 0 {
+		return dec.Decimal{}, 0, ErrEmpty
+	}
+	if len(values) < minCount {
+		return dec.Decimal{}, 0, ErrNotEnoughValues
+	}
+	sum := dec.Sum(values[0], values[1:]...)
+	sp, err := precision(2 * int(sum.Precision()))
+	if err != nil {
+		return dec.Decimal{}, 0, err
+	}
+	squares := dec.Zero(sp).Var()
+	for _, x := range values {
+		squares.Add(x.MulRound(x, sp, dec.ToZero))
+	}
+	n := int64(len(values))
+	squares.MulRound(dec.FromInt64(n, dec.Z), sp, dec.ToZero)
+	return squares.Sub(sum.MulRound(sum, sp, dec.ToZero)).Val(), n, nil
+}
+
+// sqrtQuo returns sqrt(num/den) correctly rounded to p.
+func sqrtQuo(num, den dec.Decimal, p dec.Precision, m dec.RoundingMode) (dec.Decimal, error) {
+	// the squares of the rounding boundaries of p have at most 2p+2 digits,
+	// so the truncated quotient with the sticky unit rounds the same way as the exact one.
+	qp, err := precision(max(2*int(p)+2, int(num.Precision())))
+	if err != nil {
+		return dec.Decimal{}, err
+	}
+	// the exactness check and the sticky unit need the digits after qp.
+	if _, err := precision(int(qp) + max(int(den.Precision()), 1)); err != nil {
+		return dec.Decimal{}, err
+	}
+	quo := num.QuoRound(den, qp, dec.ToZero)
+	if quo.MulRound(den, qp+den.Precision(), dec.ToZero).Cmp(num) != 0 {
+		quo = quo.Rescale(qp + 1).Add(dec.Unit(qp + 1))
+	}
+	return quo.Sqrt(p, m)
+}
+
+// precision returns the digits as a precision or ErrTooManyDigits if they exceed the maximum precision.
+func precision(digits int) (dec.Precision, error) {
+	if digits > math.MaxUint16 {
+		return 0, ErrTooManyDigits.Explainf("%d digits", digits)
+	}
+	return dec.Precision(digits), nil
+}
+
+// mirror returns the rounding mode which rounds the magnitude of a negative value
+// the same way as m rounds the value itself.
+func mirror(m dec.RoundingMode) dec.RoundingMode {
+	switch m {
+	case dec.HalfUp:
+		return dec.HalfDown
+	case dec.HalfDown:
+		return dec.HalfUp
+	case dec.ToPositiveInf:
+		return dec.ToNegativeInf
+	case dec.ToNegativeInf:
+		return dec.ToPositiveInf
+	}
+	return m
+}
+
+// roundTo rounds d to p or rescales it up to p.
+func roundTo(d dec.Decimal, p dec.Precision, m dec.RoundingMode) dec.Decimal {
+	return d.Round(p, m).Rescale(p)
+}
+
+func sorted(values []dec.Decimal) []dec.Decimal {
+	s := slices.Clone(values)
+	slices.SortStableFunc(s, dec.Decimal.Cmp)
+	return s
+}
@@ -0,0 +1,127 @@
+package stats
+
+import (
+	"errors"
+	"testing"
+
+	dec "github.com/pr0n1x/decimal-go"
+)
+
+func parseAll(values ...string) []dec.Decimal {
+	res := make([]dec.Decimal, len(values))
+	for i, v := range values {
+		res[i] = dec.Centi.MustParse(v)
+	}
+	return res
+}
+
+func Test_MeanMedian(t *testing.T) {
+	values := parseAll("10.5", "2.25", "7", "3.33")
+	if got, expected := must(Mean(values, dec.Milli, dec.HalfEven)).String(), "5.77"; got != expected {
+		t.Fatalf("invalid Mean, expected %s, got %s", expected, got)
+	}
+	if got, expected := must(Mean(values, dec.Centi, dec.ToZero)).String(), "5.77"; got != expected {
+		t.Fatalf("invalid Mean, expected %s, got %s", expected, got)
+	}
+	if got, expected := must(Median(values, dec.Centi, dec.HalfEven)).String(), "5.16"; got != expected {
+		t.Fatalf("invalid Median, expected %s, got %s", expected, got)
+	}
+	if got, expected := must(Median(values[:3], dec.Nano, dec.HalfEven)).String(), "7"; got != expected {
+		t.Fatalf("invalid Median, expected %s, got %s", expected, got)
+	}
+	if _, err := Mean(nil, dec.Nano, dec.HalfEven); !errors.Is(err, ErrEmpty) {
+		t.Fatalf("expected ErrEmpty, got %v", err)
+	}
+}
+
+func Test_Mode(t *testing.T) {
+	modes := must(Mode(parseAll("1.01", "2", "1.04", "3", "2.01", "5"), dec.Deci, dec.HalfEven))
+	if len(modes) != 2 || modes[0].String() != "1" || modes[1].String() != "2" {
+		t.Fatalf("invalid Mode, got %v", modes)
+	}
+	if modes[0].Precision() != dec.Deci {
+		t.Fatalf("invalid Mode precision, got %d", modes[0].Precision())
+	}
+}
+
+func Test_Variance(t *testing.T) {
+	// python: statistics.pvariance, statistics.variance, statistics.pstdev, statistics.stdev.
+	values := parseAll("2", "4", "4", "4", "5", "5", "7", "9.5")
+	for _, tc := range []struct {
+		name string
+		f    func([]dec.Decimal, dec.Precision, dec.RoundingMode) (dec.Decimal, error)
+		e    string
+	}{
+		{name: "Variance", f: Variance, e: "4.527344"},
+		{name: "SampleVariance", f: SampleVariance, e: "5.174107"},
+		{name: "StdDev", f: StdDev, e: "2.127756"},
+		{name: "SampleStdDev", f: SampleStdDev, e: "2.274666"},
+	} {
+		if got, expected := must(tc.f(values, dec.Micro, dec.HalfEven)).String(), tc.e; got != expected {
+			t.Fatalf("invalid %s, expected %s, got %s", tc.name, expected, got)
+		}
+	}
+	if got, expected := must(StdDev(parseAll("1", "3"), dec.Nano, dec.HalfEven)).String(), "1"; got != expected {
+		t.Fatalf("invalid exact StdDev, expected %s, got %s", expected, got)
+	}
+	if _, err := SampleVariance(parseAll("1"), dec.Nano, dec.HalfEven); !errors.Is(err, ErrNotEnoughValues) {
+		t.Fatalf("expected ErrNotEnoughValues, got %v", err)
+	}
+	// 2 * 40000 digits wrap around uint16.
+	fine := []dec.Decimal{dec.Zero(40000), dec.Unit(40000)}
+	if _, err := Variance(fine, dec.Micro, dec.HalfEven); !errors.Is(err, ErrTooManyDigits) {
+		t.Fatalf("expected ErrTooManyDigits for the variance, got %v", err)
+	}
+	if _, err := StdDev(values, 40000, dec.HalfEven); !errors.Is(err, ErrTooManyDigits) {
+		t.Fatalf("expected ErrTooManyDigits for the standard deviation, got %v", err)
+	}
+}
+
+func Test_Percentile(t *testing.T) {
+	// numpy.percentile([1, 2, 3, 4, 10], 30, method=...).
+	values := parseAll("10", "2", "4", "1", "3")
+	q := dec.Z.FromInt64(30)
+	for _, tc := range []struct {
+		method Interpolation
+		e      string
+	}{
+		{method: Linear, e: "2.2"},
+		{method: Lower, e: "2"},
+		{method: Higher, e: "3"},
+		{method: Nearest, e: "2"},
+		{method: Midpoint, e: "2.5"},
+	} {
+		if got, expected := must(Percentile(values, q, tc.method, dec.Centi, dec.HalfEven)).String(), tc.e; got != expected {
+			t.Fatalf("invalid Percentile with method %d, expected %s, got %s", tc.method, expected, got)
+		}
+	}
+	if got, expected := must(Percentile(values, dec.Z.FromInt64(100), Linear, dec.Centi, dec.HalfEven)).String(), "10"; got != expected {
+		t.Fatalf("invalid 100th Percentile, expected %s, got %s", expected, got)
+	}
+	if got, expected := must(Percentile(values, dec.Deci.MustParse("87.5"), Linear, dec.Z, dec.HalfEven)).String(), "7"; got != expected {
+		t.Fatalf("invalid Percentile, expected %s, got %s", expected, got)
+	}
+	if _, err := Percentile(values, dec.Z.FromInt64(101), Linear, dec.Centi, dec.HalfEven); !errors.Is(err, ErrInvalidPercentile) {
+		t.Fatalf("expected ErrInvalidPercentile, got %v", err)
+	}
+}
+
+func Test_ZScores(t *testing.T) {
+	scores := must(ZScores(parseAll("2", "4", "4", "4", "5", "5", "7", "9"), dec.Centi, dec.HalfEven))
+	expected := []string{"-1.5", "-0.5", "-0.5", "-0.5", "0", "0", "1", "2"}
+	for i, score := range scores {
+		if score.String() != expected[i] {
+			t.Fatalf("invalid z-score #%d, expected %s, got %s", i, expected[i], score)
+		}
+	}
+	if _, err := ZScores(parseAll("1", "1"), dec.Centi, dec.HalfEven); !errors.Is(err, ErrZeroDeviation) {
+		t.Fatalf("expected ErrZeroDeviation, got %v", err)
+	}
+}
+
+func must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
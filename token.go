@@ -0,0 +1,184 @@
+package dec
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/pr0n1x/go-liners/werr"
+)
+
+var (
+	ErrUnknownDenomination = werr.New("unknown token denomination")
+	ErrDenominationExists  = werr.New("token denomination is already registered")
+	ErrInvalidDenomination = werr.New("denomination should not be smaller than the atomic unit of the token")
+	ErrTokenMismatch       = werr.New("denominations of different tokens")
+	ErrSubAtomicAmount     = werr.New("amount has more digits than the atomic unit of the token")
+)
+
+// Token is a cryptocurrency with the precision of its atomic unit: Nano for TON, Atto for ETH.
+type Token struct {
+	Symbol    string
+	Precision Precision
+}
+
+// Denomination is a named unit of the token equal to 10^-Scale of the token: gwei is 10^-9 ETH.
+type Denomination struct {
+	Name  string
+	Token Token
+	Scale Precision
+}
+
+// TokenRegistry maps the case-insensitive names of the denominations to the tokens.
+// TokenRegistry is safe for concurrent use.
+type TokenRegistry struct {
+	mu    sync.RWMutex
+	units map[string]Denomination
+}
+
+// DefaultTokens is a registry of the well known tokens used by the package level functions:
+// TON (nanoton), ETH (gwei, wei), BTC (sat), USDT, SOL (lamport).
+var DefaultTokens = NewTokenRegistry()
+
+// NewTokenRegistry returns a registry of the well known tokens.
+func NewTokenRegistry() *TokenRegistry {
+	r := &TokenRegistry{units: make(map[string]Denomination)}
+	for _, t := range []struct {
+		token Token
+		units map[string]Precision
+	}{
+		{Token{"TON", Nano}, map[string]Precision{"nanoton": Nano}},
+		{Token{"ETH", Atto}, map[string]Precision{"gwei": Nano, "wei": Atto}},
+		{Token{"BTC", 8}, map[string]Precision{"sat": 8}},
+		{Token{"USDT", Micro}, nil},
+		{Token{"SOL", Nano}, map[string]Precision{"lamport": Nano}},
+	} {
+		if err := r.Register(t.token, t.units); err != nil {
+			panic(err)
+		}
+	}
+	return r
+}
+
+// Register adds the token and its denominations with their scales, e.g. "gwei": Nano for ETH.
+// Nothing is registered if any name is already taken.
+func (r *TokenRegistry) Register(token Token, denominations map[string]Precision) error {
+	units := []Denomination{{Name: token.Symbol, Token: token}}
+	for name, scale := range denominations {
+		if scale > token.Precision {
+			return ErrInvalidDenomination.Explainf("%s of %s", name, token.Symbol)
+		}
+		units = append(units, Denomination{Name: name, Token: token, Scale: scale})
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	names := make(map[string]bool, len(units))
+	for _, unit := range units {
+		name := strings.ToLower(unit.Name)
+		if _, ok := r.units[name]; ok || names[name] || name == "" {
+			return ErrDenominationExists.Explainf("%q", unit.Name)
+		}
+		names[name] = true
+	}
+	for _, unit := range units {
+		r.units[strings.ToLower(unit.Name)] = unit
+	}
+	return nil
+}
+
+// Lookup returns the denomination of the case-insensitive name.
+func (r *TokenRegistry) Lookup(name string) (Denomination, error) {
+	r.mu.RLock()
+	unit, ok := r.units[strings.ToLower(strings.TrimSpace(name))]
+	r.mu.RUnlock()
+	if !ok {
+		return Denomination{}, ErrUnknownDenomination.Explainf("%q", name)
+	}
+	return unit, nil
+}
+
+// ParseAmount parses a non-negative amount with a denomination ("1.5 TON", "3gwei")
+// and returns it in the token units with the token precision: "3 gwei" is 0.000000003 ETH.
+// The denomination is the longest registered name the amount ends with, so "5 1INCH" is 5 of 1INCH.
+func (r *TokenRegistry) ParseAmount(s string) (Decimal, Denomination, error) {
+	unit, i, ok := r.lookupSuffix(strings.TrimRightFunc(s, unicode.IsSpace))
+	if !ok {
+		return Decimal{}, Denomination{}, ErrUnknownDenomination.Explainf("%q", s)
+	}
+	number := strings.TrimSpace(s[:i])
+	if strings.HasPrefix(number, "-") || strings.HasPrefix(number, "+") {
+		offset := len(s[:i]) - len(strings.TrimLeftFunc(s[:i], unicode.IsSpace))
+		return Decimal{}, Denomination{}, &ParseError{Input: s, Offset: offset, Reason: "signed amount"}
+	}
+	digits := unit.Token.Precision - unit.Scale
+	d, err := Parse(number, digits, false)
+	if err != nil {
+		return Decimal{}, Denomination{}, err
+	}
+	if d.Precision() > digits {
+		rescaled, rem := d.RescaleRem(digits)
+		if rem.Sign() != 0 {
+			return Decimal{}, Denomination{}, ErrSubAtomicAmount.Explainf("%q", s)
+		}
+		d = rescaled
+	}
+	return movePoint(d, -int(unit.Scale)), unit, nil
+}
+
+// lookupSuffix returns the denomination with the longest name s ends with and the offset of the name in s.
+func (r *TokenRegistry) lookupSuffix(s string) (unit Denomination, offset int, ok bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	offset = len(s) + 1
+	for name, u := range r.units {
+		if i := len(s) - len(name); i >= 0 && i < offset && strings.EqualFold(s[i:], name) {
+			unit, offset, ok = u, i, true
+		}
+	}
+	return unit, offset, ok
+}
+
+// FormatAmount formats the amount of the token in the denomination: 0.000000003 ETH is "3 gwei".
+func (r *TokenRegistry) FormatAmount(d Decimal, denomination string) (string, error) {
+	unit, err := r.Lookup(denomination)
+	if err != nil {
+		return "", err
+	}
+	return movePoint(d, int(unit.Scale)).String() + " " + unit.Name, nil
+}
+
+// Convert converts the amount expressed in the from denomination to the to one of the same token exactly.
+func (r *TokenRegistry) Convert(d Decimal, from, to string) (Decimal, error) {
+	src, err := r.Lookup(from)
+	if err != nil {
+		return Decimal{}, err
+	}
+	dst, err := r.Lookup(to)
+	if err != nil {
+		return Decimal{}, err
+	}
+	if src.Token != dst.Token {
+		return Decimal{}, ErrTokenMismatch.Explainf("%s and %s", src.Name, dst.Name)
+	}
+	return movePoint(d, int(dst.Scale)-int(src.Scale)), nil
+}
+
+// RegisterToken registers the token in DefaultTokens, see TokenRegistry.Register.
+func RegisterToken(token Token, denominations map[string]Precision) error {
+	return DefaultTokens.Register(token, denominations)
+}
+
+// ParseAmount parses the amount using DefaultTokens, see TokenRegistry.ParseAmount.
+func ParseAmount(s string) (Decimal, Denomination, error) {
+	return DefaultTokens.ParseAmount(s)
+}
+
+// FormatAmount formats the amount using DefaultTokens, see TokenRegistry.FormatAmount.
+func FormatAmount(d Decimal, denomination string) (string, error) {
+	return DefaultTokens.FormatAmount(d, denomination)
+}
+
+// ConvertDenomination converts the amount using DefaultTokens, see TokenRegistry.Convert.
+func ConvertDenomination(d Decimal, from, to string) (Decimal, error) {
+	return DefaultTokens.Convert(d, from, to)
+}
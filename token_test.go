@@ -0,0 +1,132 @@
+package dec
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_ParseAmount(t *testing.T) {
+	for _, tc := range []struct {
+		input, value, token string
+		precision           Precision
+	}{
+		{"1.5 TON", "1.5", "TON", Nano},
+		{"1.5ton", "1.5", "TON", Nano},
+		{"42 nanoton", "0.000000042", "TON", Nano},
+		{"3 gwei", "0.000000003", "ETH", Atto},
+		{"1.000000001 Gwei", "0.000000001000000001", "ETH", Atto},
+		{"1 wei", "0.000000000000000001", "ETH", Atto},
+		{"0.0001 BTC", "0.0001", "BTC", 8},
+		{"2100 sat", "0.000021", "BTC", 8},
+		{"10.25 USDT", "10.25", "USDT", Micro},
+		{"5000 lamport", "0.000005", "SOL", Nano},
+		{"  7  SOL ", "7", "SOL", Nano},
+	} {
+		d, unit, err := ParseAmount(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := d.String(); got != tc.value {
+			t.Fatalf("invalid amount of %q, expected %s, got %s", tc.input, tc.value, got)
+		}
+		if unit.Token.Symbol != tc.token || d.Precision() != tc.precision {
+			t.Fatalf("invalid token of %q, expected %s with precision %d, got %s with precision %d",
+				tc.input, tc.token, tc.precision, unit.Token.Symbol, d.Precision())
+		}
+	}
+	for input, expected := range map[string]error{
+		"1.5":              ErrUnknownDenomination,
+		"1.5 DOGE":         ErrUnknownDenomination,
+		"0.5 wei":          ErrSubAtomicAmount,
+		"1.0000000001 TON": ErrSubAtomicAmount,
+		"-1 TON":           ErrInvalidDecimalString,
+		"1.2.3 TON":        ErrInvalidDecimalString,
+	} {
+		if _, _, err := ParseAmount(input); !errors.Is(err, expected) {
+			t.Fatalf("expected %v for %q, got %v", expected, input, err)
+		}
+	}
+	// the trailing zeros beyond the atomic unit are allowed.
+	if d, _, err := ParseAmount("1.000 wei"); err != nil || d.Cmp(Unit(Atto)) != 0 {
+		t.Fatalf("invalid amount of 1.000 wei, got %s, %v", d, err)
+	}
+}
+
+func Test_FormatAmount(t *testing.T) {
+	eth := Atto.MustParse("0.000000003")
+	for _, tc := range []struct {
+		d           Decimal
+		unit, value string
+	}{
+		{eth, "gwei", "3 gwei"},
+		{eth, "wei", "3000000000 wei"},
+		{eth, "ETH", "0.000000003 ETH"},
+		{Nano.MustParse("1.5"), "nanoton", "1500000000 nanoton"},
+		{FromInt64(1, 8), "SAT", "100000000 sat"},
+	} {
+		if got := must(FormatAmount(tc.d, tc.unit)); got != tc.value {
+			t.Fatalf("invalid format of %s in %s, expected %s, got %s", tc.d, tc.unit, tc.value, got)
+		}
+	}
+	if _, err := FormatAmount(eth, "DOGE"); !errors.Is(err, ErrUnknownDenomination) {
+		t.Fatalf("expected ErrUnknownDenomination, got %v", err)
+	}
+}
+
+func Test_ConvertDenomination(t *testing.T) {
+	if got, expected := must(ConvertDenomination(Deci.MustParse("1.5"), "gwei", "wei")).String(), "1500000000"; got != expected {
+		t.Fatalf("invalid conversion, expected %s, got %s", expected, got)
+	}
+	if got, expected := must(ConvertDenomination(FromInt64(2100, Z), "sat", "BTC")).String(), "0.000021"; got != expected {
+		t.Fatalf("invalid conversion, expected %s, got %s", expected, got)
+	}
+	if _, err := ConvertDenomination(One(Z), "gwei", "TON"); !errors.Is(err, ErrTokenMismatch) {
+		t.Fatalf("expected ErrTokenMismatch, got %v", err)
+	}
+}
+
+func Test_TokenRegistry(t *testing.T) {
+	r := NewTokenRegistry()
+	if err := r.Register(Token{Symbol: "NOT", Precision: Nano}, map[string]Precision{"nanonot": Nano}); err != nil {
+		t.Fatal(err)
+	}
+	if got, expected := must(r.FormatAmount(Nano.MustParse("0.25"), "nanonot")), "250000000 nanonot"; got != expected {
+		t.Fatalf("invalid format of a custom token, expected %s, got %s", expected, got)
+	}
+	if _, _, err := ParseAmount("1 NOT"); !errors.Is(err, ErrUnknownDenomination) {
+		t.Fatalf("custom token leaked to DefaultTokens: %v", err)
+	}
+	for _, tc := range []struct {
+		token    Token
+		units    map[string]Precision
+		expected error
+	}{
+		{Token{"usdt", Micro}, nil, ErrDenominationExists},
+		{Token{"WBTC", 8}, map[string]Precision{"sat": 8}, ErrDenominationExists},
+		{Token{"ABC", Micro}, map[string]Precision{"abc": Z}, ErrDenominationExists},
+		{Token{"XYZ", Micro}, map[string]Precision{"nanoxyz": Nano}, ErrInvalidDenomination},
+	} {
+		if err := r.Register(tc.token, tc.units); !errors.Is(err, tc.expected) {
+			t.Fatalf("expected %v registering %s, got %v", tc.expected, tc.token.Symbol, err)
+		}
+	}
+	if _, err := r.Lookup("WBTC"); !errors.Is(err, ErrUnknownDenomination) {
+		t.Fatalf("failed registration should not add the token, got %v", err)
+	}
+	// the symbols starting with a digit and the names which are suffixes of the other ones.
+	if err := r.Register(Token{Symbol: "1INCH", Precision: Atto}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Register(Token{Symbol: "INCH", Precision: Centi}, nil); err != nil {
+		t.Fatal(err)
+	}
+	for input, expected := range map[string]string{"5 1INCH": "1INCH", "51inch": "1INCH", "5 INCH": "INCH", "2.5inch ": "INCH"} {
+		d, unit, err := r.ParseAmount(input)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", input, err)
+		}
+		if unit.Token.Symbol != expected || d.Precision() != unit.Token.Precision {
+			t.Fatalf("invalid token of %q, expected %s, got %s with precision %d", input, expected, unit.Token.Symbol, d.Precision())
+		}
+	}
+}
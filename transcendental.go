@@ -0,0 +1,398 @@
+package dec
+
+import (
+	"context"
+	"math"
+	"math/big"
+
+	"github.com/pr0n1x/go-liners/werr"
+)
+
+var (
+	ErrNonPositiveLog   = werr.New("logarithm of a non-positive decimal")
+	ErrInvalidLogBase   = werr.New("logarithm base should be positive and not equal to one")
+	ErrNegativePowBase  = werr.New("non-integer power of a negative decimal")
+	ErrPowOverflow      = werr.New("power has more than 65535 integer digits")
+	ErrNotConverged     = werr.New("correctly rounded result requires too many guard digits")
+	maxRationalLogDenom = big.NewInt(1000)
+)
+
+const (
+	// checkCancelEvery is a number of series iterations between the context cancellation checks.
+	checkCancelEvery = 32
+	// maxGuardDigits limits the guard digits of the correctly rounded results,
+	// the results which are that close to a rounding boundary and aren't detected as exact are treated as errors.
+	maxGuardDigits = 1 << 11
+)
+
+// Exp returns e^d correctly rounded to the resultPrecision using the mode m.
+// The computation could be cancelled using ctx, its error is returned in this case.
+// It returns ErrPowOverflow if the result has more than 65535 integer digits.
+func (d Decimal) Exp(ctx context.Context, resultPrecision Precision, m RoundingMode) (Decimal, error) {
+	if !m.valid() {
+		panic("invalid rounding mode")
+	}
+	if d.Sign() == 0 {
+		return One(resultPrecision), nil
+	}
+	xf, _ := new(big.Rat).SetFrac(d.Units(), d.Precision().multiplierOnlyForReadIPromise()).Float64()
+	if xf/math.Ln10 > math.MaxUint16 {
+		return Decimal{}, ErrPowOverflow
+	}
+	// e^d is less than a hundredth of the unit, it rounds as any positive value below the half of the unit.
+	if xf/math.Ln10 < -float64(resultPrecision)-2 {
+		units := big.NewInt(1)
+		quoRound(units, units, big.NewInt(10), m)
+		return FromUnits(units, resultPrecision), nil
+	}
+	return correctlyRounded(ctx, resultPrecision, m, 1, func(ctx context.Context, digits int64) (*big.Int, error) {
+		return expFixed(ctx, d, digits)
+	}, nil)
+}
+
+// Ln returns the natural logarithm of d correctly rounded to the resultPrecision using the mode m.
+// It returns ErrNonPositiveLog if d <= 0.
+func (d Decimal) Ln(ctx context.Context, resultPrecision Precision, m RoundingMode) (Decimal, error) {
+	if !m.valid() {
+		panic("invalid rounding mode")
+	}
+	if d.Sign() <= 0 {
+		return Decimal{}, ErrNonPositiveLog
+	}
+	sign := d.Cmp(One(0))
+	if sign == 0 {
+		return Zero(resultPrecision), nil
+	}
+	return correctlyRounded(ctx, resultPrecision, m, sign, func(ctx context.Context, digits int64) (*big.Int, error) {
+		return lnFixed(ctx, d, digits)
+	}, nil)
+}
+
+// Log10 returns the decimal logarithm of d correctly rounded to the resultPrecision using the mode m.
+func (d Decimal) Log10(ctx context.Context, resultPrecision Precision, m RoundingMode) (Decimal, error) {
+	return d.Log(ctx, Ten(0), resultPrecision, m)
+}
+
+// Log2 returns the binary logarithm of d correctly rounded to the resultPrecision using the mode m.
+func (d Decimal) Log2(ctx context.Context, resultPrecision Precision, m RoundingMode) (Decimal, error) {
+	return d.Log(ctx, FromUInt64(2, 0), resultPrecision, m)
+}
+
+// Log returns the logarithm of d to the base correctly rounded to the resultPrecision using the mode m.
+// Rational results like Log2(0.125) == -3 or Log(2, base 4) == 0.5 are detected and rounded exactly.
+func (d Decimal) Log(ctx context.Context, base Decimal, resultPrecision Precision, m RoundingMode) (Decimal, error) {
+	if !m.valid() {
+		panic("invalid rounding mode")
+	}
+	if d.Sign() <= 0 {
+		return Decimal{}, ErrNonPositiveLog
+	}
+	baseSign := base.Cmp(One(0))
+	if base.Sign() <= 0 || baseSign == 0 {
+		return Decimal{}, ErrInvalidLogBase
+	}
+	sign := d.Cmp(One(0)) * baseSign
+	if sign == 0 {
+		return Zero(resultPrecision), nil
+	}
+	// the error of ln(d)/ln(base) grows when ln(base) is close to zero or ln(d) is large.
+	lnBase, lnD := math.Abs(lnEstimate(base)), math.Abs(lnEstimate(d))
+	extra := int64(math.Ceil(math.Log10(1/lnBase)))*2 + int64(math.Ceil(math.Log10(lnD+1))) + 2
+	if extra < 0 {
+		extra = 0
+	}
+	approx := func(ctx context.Context, digits int64) (*big.Int, error) {
+		num, err := lnFixed(ctx, d, digits+extra)
+		if err != nil {
+			return nil, err
+		}
+		den, err := lnFixed(ctx, base, digits+extra)
+		if err != nil {
+			return nil, err
+		}
+		return num.Mul(num, pow10(digits)).Quo(num, den), nil
+	}
+	exact := func(approx *big.Int, digits int64) (Decimal, bool) {
+		return rationalLog(d, base, approx, digits, resultPrecision, m)
+	}
+	return correctlyRounded(ctx, resultPrecision, m, sign, approx, exact)
+}
+
+// PowDec returns d^exp correctly rounded to the resultPrecision using the mode m.
+// An integer exp is computed exactly by Pow, a non-integer exp requires a non-negative d
+// and is computed as e^(exp*ln(d)), the exactly representable results like 4^0.5 are detected.
+// It returns ErrPowOverflow if the result has more than 65535 integer digits.
+func (d Decimal) PowDec(exp Decimal, resultPrecision Precision, m RoundingMode) (Decimal, error) {
+	return d.PowDecContext(context.Background(), exp, resultPrecision, m)
+}
+
+// PowDecContext is the same as PowDec but could be cancelled using ctx.
+func (d Decimal) PowDecContext(ctx context.Context, exp Decimal, resultPrecision Precision, m RoundingMode) (Decimal, error) {
+	if !m.valid() {
+		panic("invalid rounding mode")
+	}
+	expRat := new(big.Rat).SetFrac(exp.Units(), exp.Precision().multiplierOnlyForReadIPromise())
+	if expRat.IsInt() && expRat.Num().IsInt64() {
+		return d.TryPow(expRat.Num().Int64(), resultPrecision, m)
+	}
+	switch d.Sign() {
+	case 0:
+		if exp.Sign() < 0 {
+			return Decimal{}, ErrDivisionByZero
+		}
+		return Zero(resultPrecision), nil
+	case -1:
+		if !expRat.IsInt() {
+			return Decimal{}, ErrNegativePowBase
+		}
+	}
+	sign := 1
+	if d.Sign() < 0 && expRat.Num().Bit(0) != 0 {
+		sign = -1
+	}
+	// the powers of one are exact whatever the exponent is, an integer exp gets here if it isn't int64.
+	if d.Abs().Cmp(One(0)) == 0 {
+		return FromInt64(int64(sign), resultPrecision), nil
+	}
+	// the absolute error of exp*ln(d) becomes the relative error of the result.
+	expF, _ := expRat.Float64()
+	est := expF * lnEstimate(d.Abs())
+	if est/math.Ln10 > math.MaxUint16 {
+		return Decimal{}, ErrPowOverflow
+	}
+	// the exact results don't have more digits than the maximum precision.
+	if math.Abs(est)/math.Ln10 <= math.MaxUint16 {
+		if res, ok := exactRationalPow(d, expRat, resultPrecision, m); ok {
+			return res, nil
+		}
+	}
+	extra := int64(math.Ceil(math.Max(est, 0)/math.Ln10)) + int64(math.Ceil(math.Log10(math.Abs(expF)+1))) + 5
+	return correctlyRounded(ctx, resultPrecision, m, sign, func(ctx context.Context, digits int64) (*big.Int, error) {
+		w := digits + extra
+		lnD, err := lnFixed(ctx, d.Abs(), w)
+		if err != nil {
+			return nil, err
+		}
+		lnD.Mul(lnD, &exp.p.val).Quo(lnD, exp.Precision().multiplierOnlyForReadIPromise())
+		res, err := expFixed(ctx, FromUnits(lnD, Precision(w)), digits)
+		if err != nil {
+			return nil, err
+		}
+		if sign < 0 {
+			res.Neg(res)
+		}
+		return res, nil
+	}, nil)
+}
+
+// exactRationalPow computes x^(a/b) when x has the exact b-th root, the root index is limited
+// and the caller limits the magnitude of the result.
+func exactRationalPow(x Decimal, exp *big.Rat, p Precision, m RoundingMode) (Decimal, bool) {
+	b := exp.Denom()
+	if b.Cmp(maxRationalLogDenom) > 0 || !exp.Num().IsInt64() || x.Sign() < 0 {
+		return Decimal{}, false
+	}
+	n := b.Int64()
+	xRat := new(big.Rat).SetFrac(x.Units(), x.Precision().multiplierOnlyForReadIPromise())
+	num, den := &big.Int{}, &big.Int{}
+	if !intRoot(num, xRat.Num(), n) || !intRoot(den, xRat.Denom(), n) {
+		return Decimal{}, false
+	}
+	a := exp.Num().Int64()
+	res := ratPow(new(big.Rat).SetFrac(num, den), a)
+	return FromUnits(res.Num(), 0).QuoRound(FromUnits(res.Denom(), 0), p, m), true
+}
+
+// correctlyRounded evaluates approx with a growing number of guard digits
+// until the interval of its error rounds to the same value (Ziv's strategy),
+// ErrNotConverged is returned if it doesn't happen within maxGuardDigits.
+// sign is a known sign of the exact result or zero, exact is an optional check
+// for the results which could be exactly representable and prevent the convergence.
+func correctlyRounded(
+	ctx context.Context,
+	p Precision,
+	m RoundingMode,
+	sign int,
+	approx func(ctx context.Context, digits int64) (*big.Int, error),
+	exact func(approx *big.Int, digits int64) (Decimal, bool),
+) (Decimal, error) {
+	for guard := int64(8); guard <= maxGuardDigits; guard *= 2 {
+		if err := ctx.Err(); err != nil {
+			return Decimal{}, err
+		}
+		digits := int64(p) + guard
+		a, err := approx(ctx, digits)
+		if err != nil {
+			return Decimal{}, err
+		}
+		// approx is guaranteed to be within 2 units of the exact value.
+		lo := (&big.Int{}).Sub(a, big.NewInt(2))
+		hi := (&big.Int{}).Add(a, big.NewInt(2))
+		// bounds of the same sign as the exact value round the same way as the value itself.
+		if sign > 0 && lo.Sign() <= 0 {
+			lo.SetInt64(1)
+		}
+		if sign < 0 && hi.Sign() >= 0 {
+			hi.SetInt64(-1)
+		}
+		unit := pow10(guard)
+		quoRound(lo, lo, unit, m)
+		quoRound(hi, hi, unit, m)
+		if lo.Cmp(hi) == 0 {
+			return FromUnits(lo, p), nil
+		}
+		if exact != nil {
+			if res, ok := exact(a, digits); ok {
+				return res, nil
+			}
+		}
+	}
+	return Decimal{}, ErrNotConverged
+}
+
+// lnEstimate returns float64 approximation of ln(d) for a positive d avoiding the float64 overflow.
+func lnEstimate(d Decimal) float64 {
+	mant := big.Float{}
+	exp := mant.SetInt(&d.p.val).MantExp(&mant)
+	f, _ := mant.Float64()
+	return math.Log(f) + float64(exp)*math.Ln2 - float64(d.p.exp)*math.Ln10
+}
+
+// toFixed returns units of d at the precision of the given digits, extra digits are truncated.
+func toFixed(d Decimal, digits int64) *big.Int {
+	x := d.Units()
+	if shift := digits - int64(d.Precision()); shift >= 0 {
+		return x.Mul(x, pow10(shift))
+	} else {
+		return x.Quo(x, pow10(-shift))
+	}
+}
+
+// expFixed returns units of e^x at the precision of the given digits with the error less than a unit.
+func expFixed(ctx context.Context, x Decimal, digits int64) (*big.Int, error) {
+	xf, _ := new(big.Rat).SetFrac(x.Units(), x.Precision().multiplierOnlyForReadIPromise()).Float64()
+	// argument reduction: e^x == (e^(x/2^s))^(2^s) where |x/2^s| < 2^-10.
+	s := int64(0)
+	if ax := math.Abs(xf); ax > 1.0/1024 {
+		s = int64(math.Ceil(math.Log2(ax))) + 10
+	}
+	// every squaring doubles the error, and the integer digits of the result need the precision too.
+	intDigits := int64(math.Ceil(math.Max(xf, 0)/math.Ln10)) + 1
+	w := digits + intDigits + s*3/10 + 10
+	one := pow10(w)
+
+	r := toFixed(x, w)
+	r.Quo(r, (&big.Int{}).Lsh(big.NewInt(1), uint(s)))
+
+	// Taylor series: e^r == sum(r^i / i!).
+	sum := (&big.Int{}).Set(one)
+	term := (&big.Int{}).Set(one)
+	for i := int64(1); term.Sign() != 0; i++ {
+		if i%checkCancelEvery == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		term.Mul(term, r).Quo(term, one).Quo(term, big.NewInt(i))
+		sum.Add(sum, term)
+	}
+	// the squarings of the large results are slow, the cancellation is checked on each of them.
+	for i := int64(0); i < s; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		sum.Mul(sum, sum).Quo(sum, one)
+	}
+	return sum.Quo(sum, pow10(w-digits)), nil
+}
+
+// lnFixed returns units of ln(x) for a positive x at the precision of the given digits
+// with the error less than a unit.
+func lnFixed(ctx context.Context, x Decimal, digits int64) (*big.Int, error) {
+	est := lnEstimate(x)
+	// argument reduction: ln(x) == 2^s * ln(x^(1/2^s)) where x^(1/2^s) is close to 1.
+	s := int64(0)
+	if ae := math.Abs(est); ae > 1.0/1024 {
+		s = int64(math.Ceil(math.Log2(ae))) + 10
+	}
+	// the error is multiplied by 2^s, and x lower than 1 needs the precision for the leading zeroes.
+	leadZeroes := int64(math.Ceil(math.Max(-est, 0) / math.Ln10))
+	w := digits + s*3/10 + leadZeroes + 10
+	one := pow10(w)
+
+	y := toFixed(x, w)
+	for i := int64(0); i < s; i++ {
+		if i%checkCancelEvery == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		y.Mul(y, one).Sqrt(y)
+	}
+
+	// ln(y) == 2 * atanh(z) == 2 * sum(z^(2k+1) / (2k+1)), where z = (y-1)/(y+1).
+	z := (&big.Int{}).Sub(y, one)
+	z.Mul(z, one).Quo(z, y.Add(y, one))
+	z2 := (&big.Int{}).Mul(z, z)
+	z2.Quo(z2, one)
+	sum := (&big.Int{}).Set(z)
+	power, term := (&big.Int{}).Set(z), &big.Int{}
+	for k := int64(1); power.Sign() != 0; k++ {
+		if k%checkCancelEvery == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		power.Mul(power, z2).Quo(power, one)
+		term.Quo(power, big.NewInt(2*k+1))
+		sum.Add(sum, term)
+	}
+	sum.Lsh(sum, uint(s+1))
+	return sum.Quo(sum, pow10(w-digits)), nil
+}
+
+// rationalLog looks for the exact rational logarithm num/den of x to the base
+// among the continued fraction convergents of the approximation, checking base^num == x^den.
+// The powers are compared only if their magnitudes agree, so a large num costs no more than x^den.
+func rationalLog(x, base Decimal, approx *big.Int, digits int64, p Precision, m RoundingMode) (Decimal, bool) {
+	xr := new(big.Rat).SetFrac(x.Units(), x.Precision().multiplierOnlyForReadIPromise())
+	br := new(big.Rat).SetFrac(base.Units(), base.Precision().multiplierOnlyForReadIPromise())
+	lnX, lnBase := lnEstimate(x), lnEstimate(base)
+	r := new(big.Rat).SetFrac(approx, pow10(digits))
+	h1, h2 := big.NewInt(1), big.NewInt(0)
+	k1, k2 := big.NewInt(0), big.NewInt(1)
+	a, frac := &big.Int{}, &big.Rat{}
+	for {
+		a.Div(r.Num(), r.Denom())
+		h := (&big.Int{}).Mul(a, h1)
+		h.Add(h, h2)
+		k := (&big.Int{}).Mul(a, k1)
+		k.Add(k, k2)
+		if k.Cmp(maxRationalLogDenom) > 0 || !h.IsInt64() {
+			return Decimal{}, false
+		}
+		hf, kf := float64(h.Int64()), float64(k.Int64())
+		if math.Abs(hf*lnBase-kf*lnX) <= 1e-9*(math.Abs(kf*lnX)+1) && ratPow(br, h.Int64()).Cmp(ratPow(xr, k.Int64())) == 0 {
+			return FromUnits(h, 0).QuoRound(FromUnits(k, 0), p, m), true
+		}
+		frac.SetInt(a)
+		frac.Sub(r, frac)
+		if frac.Sign() == 0 {
+			return Decimal{}, false
+		}
+		r.Inv(frac)
+		h1, h2 = h, h1
+		k1, k2 = k, k1
+	}
+}
+
+func ratPow(x *big.Rat, n int64) *big.Rat {
+	e := big.NewInt(n)
+	e.Abs(e)
+	num := (&big.Int{}).Exp(x.Num(), e, nil)
+	den := (&big.Int{}).Exp(x.Denom(), e, nil)
+	if n < 0 {
+		num, den = den, num
+	}
+	return new(big.Rat).SetFrac(num, den)
+}
@@ -0,0 +1,231 @@
+package dec
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func Test_Exp(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []roundTestCase{
+		{n: Z.FromInt64(1), r: 40, e: "2.7182818284590452353602874713526624977572"},
+		{n: Z.FromInt64(-1), r: 40, e: "0.3678794411714423215955237701614608674458"},
+		{n: Deci.MustParse("0.5"), r: Quecto, e: "1.648721270700128146848650787814"},
+		{n: Z.FromInt64(10), r: Nano, e: "22026.465794807"},
+		{n: Micro.MustParse("0.000001"), r: 40, e: "1.0000010000005000001666667083333416666681"},
+		{n: Z.FromInt64(-100), r: Atto, e: "0"},
+		{n: Nano.Zero(), r: Milli, e: "1"},
+	} {
+		res, err := tc.n.Exp(ctx, tc.r, HalfEven)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, expected := res.String(), tc.e; got != expected {
+			t.Fatalf("invalid Exp of %s, expected %s, got %s", tc.n, expected, got)
+		}
+		if got, expected := res.Precision(), tc.r; got != expected {
+			t.Fatalf("invalid Exp result precision, expected %d, got %d", expected, got)
+		}
+	}
+	// e^-100 is a tiny positive number.
+	if got, expected := must(Z.FromInt64(-100).Exp(ctx, Atto, ToPositiveInf)).String(), "0.000000000000000001"; got != expected {
+		t.Fatalf("invalid Exp rounded up, expected %s, got %s", expected, got)
+	}
+}
+
+func Test_ExpLarge(t *testing.T) {
+	// the guards don't depend on the cancellation, the context only keeps a regression from hanging.
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	for _, n := range []Decimal{Z.FromInt64(1000000), MustParseSci("1e400", Z, false)} {
+		if _, err := n.Exp(ctx, Nano, HalfEven); !errors.Is(err, ErrPowOverflow) {
+			t.Fatalf("expected ErrPowOverflow for Exp of %s, got %v", n, err)
+		}
+	}
+	for m, expected := range map[RoundingMode]string{HalfEven: "0", ToZero: "0", ToPositiveInf: "0.000000001"} {
+		res, err := MustParseSci("-1e400", Z, false).Exp(ctx, Nano, m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := res.String(); got != expected || res.Precision() != Nano {
+			t.Fatalf("invalid Exp of -1e400 rounded with %d, expected %s, got %s", m, expected, got)
+		}
+	}
+}
+
+func Test_Ln(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []roundTestCase{
+		{n: Z.FromInt64(2), r: 40, e: "0.6931471805599453094172321214581765680755"},
+		{n: Deci.MustParse("0.5"), r: 40, e: "-0.6931471805599453094172321214581765680755"},
+		{n: Nano.MustParse("0.000000001"), r: Atto, e: "-20.723265836946411156"},
+		{n: Milli.MustParse("123456789.123"), r: 39, e: "18.631401767164318041763956576763670273401"},
+		{n: Nano.One(), r: Nano, e: "0"},
+	} {
+		res, err := tc.n.Ln(ctx, tc.r, HalfEven)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, expected := res.String(), tc.e; got != expected {
+			t.Fatalf("invalid Ln of %s, expected %s, got %s", tc.n, expected, got)
+		}
+	}
+}
+
+func Test_Log(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		n Decimal
+		b Decimal
+		r Precision
+		m RoundingMode
+		e string
+	}{
+		{n: Z.FromInt64(2), b: Ten(0), r: Quecto, m: HalfEven, e: "0.301029995663981195213738894724"},
+		{n: Z.FromInt64(10), b: Z.FromInt64(2), r: Quecto, m: HalfEven, e: "3.321928094887362347870319429489"},
+		{n: Nano.MustParse("0.000000001"), b: Ten(0), r: Quecto, m: HalfEven, e: "-9"},
+		{n: Milli.MustParse("0.125"), b: Z.FromInt64(2), r: Z, m: ToPositiveInf, e: "-3"},
+		{n: Z.FromInt64(1000), b: Ten(0), r: Nano, m: ToNegativeInf, e: "3"},
+		{n: Z.FromInt64(2), b: Z.FromInt64(4), r: Z, m: HalfEven, e: "0"},
+		{n: Z.FromInt64(2), b: Z.FromInt64(4), r: Z, m: HalfUp, e: "1"},
+		{n: Z.FromInt64(8), b: Z.FromInt64(4), r: Centi, m: HalfEven, e: "1.5"},
+		{n: Z.FromInt64(8), b: Deci.MustParse("0.5"), r: Centi, m: HalfEven, e: "-3"},
+	} {
+		res, err := tc.n.Log(ctx, tc.b, tc.r, tc.m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, expected := res.String(), tc.e; got != expected {
+			t.Fatalf("invalid Log of %s to the base %s, expected %s, got %s", tc.n, tc.b, expected, got)
+		}
+	}
+	if got, expected := must(Z.FromInt64(1024).Log2(ctx, Nano, HalfEven)).String(), "10"; got != expected {
+		t.Fatalf("invalid Log2, expected %s, got %s", expected, got)
+	}
+	if got, expected := must(Centi.MustParse("0.01").Log10(ctx, Nano, HalfEven)).String(), "-2"; got != expected {
+		t.Fatalf("invalid Log10, expected %s, got %s", expected, got)
+	}
+}
+
+func Test_LogExactLarge(t *testing.T) {
+	ctx := context.Background()
+	x := FromUnits(new(big.Int).Lsh(big.NewInt(1), 70000), Z)
+	for _, m := range []RoundingMode{ToZero, ToPositiveInf, HalfEven} {
+		if got, err := x.Log2(ctx, Z, m); err != nil || got.String() != "70000" {
+			t.Fatalf("invalid Log2(2^70000) with mode %d: %s, %v", m, got, err)
+		}
+	}
+	if got, err := x.Log(ctx, Z.FromInt64(8), Milli, ToZero); err != nil || got.String() != "23333.333" {
+		t.Fatalf("invalid Log8(2^70000): %s, %v", got, err)
+	}
+}
+
+func Test_CorrectlyRoundedLimit(t *testing.T) {
+	// the approximation of the value 1 never rounds the same way with the ToZero mode.
+	_, err := correctlyRounded(context.Background(), Z, ToZero, 0, func(_ context.Context, digits int64) (*big.Int, error) {
+		return pow10(digits), nil
+	}, nil)
+	if !errors.Is(err, ErrNotConverged) {
+		t.Fatalf("expected ErrNotConverged, got %v", err)
+	}
+}
+
+func Test_LogErrors(t *testing.T) {
+	ctx := context.Background()
+	if _, err := Nano.Zero().Ln(ctx, Nano, HalfEven); !errors.Is(err, ErrNonPositiveLog) {
+		t.Fatalf("expected ErrNonPositiveLog, got %v", err)
+	}
+	if _, err := Nano.FromInt64(-2).Log10(ctx, Nano, HalfEven); !errors.Is(err, ErrNonPositiveLog) {
+		t.Fatalf("expected ErrNonPositiveLog, got %v", err)
+	}
+	if _, err := Nano.FromInt64(2).Log(ctx, Nano.One(), Nano, HalfEven); !errors.Is(err, ErrInvalidLogBase) {
+		t.Fatalf("expected ErrInvalidLogBase, got %v", err)
+	}
+}
+
+func Test_TranscendentalCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := Z.FromInt64(2).Exp(ctx, 10000, HalfEven); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if _, err := Z.FromInt64(2).Ln(ctx, 10000, HalfEven); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func Test_PowDec(t *testing.T) {
+	for _, tc := range []struct {
+		n Decimal
+		x Decimal
+		r Precision
+		m RoundingMode
+		e string
+	}{
+		{n: Centi.MustParse("1.05"), x: MustParse("0.0849315068", Z, false), r: Atto, m: HalfEven, e: "1.004152419662380886"},
+		{n: Z.FromInt64(2), x: Deci.MustParse("0.5"), r: Quecto, m: HalfEven, e: "1.41421356237309504880168872421"},
+		{n: Deci.MustParse("1.5"), x: Deci.MustParse("2.5").Neg(), r: Atto, m: HalfEven, e: "0.36288736930121157"},
+		{n: Z.FromInt64(10), x: Deci.MustParse("40.5"), r: Nano, m: HalfEven, e: "31622776601683793319988935444327185337195.551393252"},
+		{n: Z.FromInt64(4), x: Deci.MustParse("0.5"), r: Nano, m: ToPositiveInf, e: "2"},
+		{n: Centi.MustParse("6.25"), x: Deci.MustParse("1.5"), r: Z, m: HalfEven, e: "16"},
+		{n: Z.FromInt64(16), x: Centi.MustParse("0.25").Neg(), r: Nano, m: HalfEven, e: "0.5"},
+		{n: Deci.MustParse("1.5"), x: Nano.FromInt64(3), r: Centi, m: HalfEven, e: "3.38"},
+		{n: Z.FromInt64(-2), x: Nano.FromInt64(3), r: Centi, m: HalfEven, e: "-8"},
+		{n: Nano.Zero(), x: Deci.MustParse("0.5"), r: Centi, m: HalfEven, e: "0"},
+	} {
+		res, err := tc.n.PowDec(tc.x, tc.r, tc.m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, expected := res.String(), tc.e; got != expected {
+			t.Fatalf("invalid PowDec of %s^%s, expected %s, got %s", tc.n, tc.x, expected, got)
+		}
+		if got, expected := res.Precision(), tc.r; got != expected {
+			t.Fatalf("invalid PowDec result precision, expected %d, got %d", expected, got)
+		}
+	}
+}
+
+func Test_PowDecLarge(t *testing.T) {
+	huge := MustParse("100000000000000000000", Z, false)
+	for _, tc := range []struct {
+		n Decimal
+		x Decimal
+		r Precision
+		m RoundingMode
+		e string
+	}{
+		{n: One(Centi), x: huge, r: Centi, m: ToZero, e: "1"},
+		{n: One(Centi), x: Deci.MustParse("0.5"), r: Centi, m: ToZero, e: "1"},
+		{n: One(Z).Neg(), x: huge.Add(One(Z)), r: Z, m: ToPositiveInf, e: "-1"},
+		{n: Deci.MustParse("0.5"), x: huge, r: Centi, m: ToZero, e: "0"},
+		{n: Deci.MustParse("0.5"), x: huge, r: Centi, m: ToPositiveInf, e: "0.01"},
+		{n: Z.FromInt64(4), x: Deci.MustParse("50000.5"), r: Z, m: ToZero, e: new(big.Int).Lsh(big.NewInt(1), 100001).String()},
+	} {
+		res, err := tc.n.PowDec(tc.x, tc.r, tc.m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, expected := res.String(), tc.e; got != expected || res.Precision() != tc.r {
+			t.Fatalf("invalid PowDec of %s^%s, expected %s, got %s", tc.n, tc.x, expected, got)
+		}
+	}
+}
+
+func Test_PowDecErrors(t *testing.T) {
+	if _, err := Z.FromInt64(-8).PowDec(Deci.MustParse("0.5"), Nano, HalfEven); !errors.Is(err, ErrNegativePowBase) {
+		t.Fatalf("expected ErrNegativePowBase, got %v", err)
+	}
+	if _, err := Nano.Zero().PowDec(Deci.MustParse("0.5").Neg(), Nano, HalfEven); !errors.Is(err, ErrDivisionByZero) {
+		t.Fatalf("expected ErrDivisionByZero, got %v", err)
+	}
+	if _, err := Nano.Zero().PowDec(Z.FromInt64(-1), Nano, HalfEven); !errors.Is(err, ErrDivisionByZero) {
+		t.Fatalf("expected ErrDivisionByZero, got %v", err)
+	}
+	if _, err := Z.FromInt64(2).PowDec(MustParse("100000000000000000000", Z, false), Nano, HalfEven); !errors.Is(err, ErrPowOverflow) {
+		t.Fatalf("expected ErrPowOverflow, got %v", err)
+	}
+}
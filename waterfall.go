@@ -0,0 +1,73 @@
+package dec
+
+import (
+	"sort"
+
+	"github.com/pr0n1x/go-liners/werr"
+)
+
+var (
+	ErrNegativeTotal  = werr.New("distributed total is negative")
+	ErrInvalidTranche = werr.New("tranche minimum and cap should be non-negative and the cap should not be less than the minimum")
+)
+
+// Tranche is a recipient of a Waterfall distribution.
+type Tranche struct {
+	Name     string
+	Priority int      // the lower value is paid first, equal priorities are paid in the given order.
+	Min      Decimal  // the floor which is paid before any tranche gets more than its floor.
+	Cap      *Decimal // the maximum payment, nil means no cap.
+}
+
+// Waterfall distributes the total over the tranches at the precision of the total.
+// At first the floors (Min) are paid in the priority order, then the tranches are topped up
+// to their caps in the same order, a tranche without a cap takes everything left.
+// Min and Cap are truncated to the precision of the total. The payments are returned
+// in the order of the given tranches, residue is the undistributed part of the total.
+func Waterfall(total Decimal, tranches ...Tranche) (payments []Decimal, residue Decimal, err error) {
+	if total.Sign() < 0 {
+		return nil, Decimal{}, ErrNegativeTotal
+	}
+	p := total.Precision()
+	floors := make([]Decimal, len(tranches))
+	caps := make([]*Decimal, len(tranches))
+	for i, t := range tranches {
+		floors[i] = roundTo(t.Min, p, ToZero)
+		if floors[i].Sign() < 0 {
+			return nil, Decimal{}, ErrInvalidTranche.Explainf("tranche %q", t.Name)
+		}
+		if t.Cap != nil {
+			c := roundTo(*t.Cap, p, ToZero)
+			if c.Cmp(floors[i]) < 0 {
+				return nil, Decimal{}, ErrInvalidTranche.Explainf("tranche %q", t.Name)
+			}
+			caps[i] = &c
+		}
+	}
+	order := make([]int, len(tranches))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return tranches[order[a]].Priority < tranches[order[b]].Priority
+	})
+
+	left := total.lhs()
+	payments = make([]Decimal, len(tranches))
+	for _, i := range order {
+		payments[i] = Min(floors[i], left.Val()).Rescale(p)
+		left.Sub(payments[i])
+	}
+	for _, i := range order {
+		if left.Val().Sign() == 0 {
+			break
+		}
+		topUp := left.Val().Copy()
+		if caps[i] != nil {
+			topUp = Min(caps[i].Sub(payments[i]), topUp)
+		}
+		payments[i] = payments[i].Add(topUp)
+		left.Sub(topUp)
+	}
+	return payments, left.Val(), nil
+}
@@ -0,0 +1,77 @@
+package dec
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_Waterfall(t *testing.T) {
+	capOf := func(s string) *Decimal {
+		c := Centi.MustParse(s)
+		return &c
+	}
+	tranches := []Tranche{
+		{Name: "equity", Priority: 3},
+		{Name: "senior", Priority: 1, Min: Centi.MustParse("10"), Cap: capOf("50")},
+		{Name: "fee", Priority: 0, Cap: capOf("5")},
+		{Name: "junior", Priority: 2, Min: Centi.MustParse("20"), Cap: capOf("30")},
+	}
+	for _, tc := range []struct {
+		total    Decimal
+		payments []string
+		residue  string
+	}{
+		{total: Centi.MustParse("200"), payments: []string{"115", "50", "5", "30"}, residue: "0"},
+		{total: Centi.MustParse("60"), payments: []string{"0", "35", "5", "20"}, residue: "0"},
+		{total: Centi.MustParse("25.5"), payments: []string{"0", "10", "0", "15.5"}, residue: "0"},
+		{total: Centi.MustParse("0"), payments: []string{"0", "0", "0", "0"}, residue: "0"},
+	} {
+		payments, residue, err := Waterfall(tc.total, tranches...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sum := residue
+		for i, payment := range payments {
+			if got, expected := payment.String(), tc.payments[i]; got != expected {
+				t.Fatalf("invalid payment to %s of %s, expected %s, got %s", tranches[i].Name, tc.total, expected, got)
+			}
+			if got, expected := payment.Precision(), Centi; got != expected {
+				t.Fatalf("invalid payment precision, expected %d, got %d", expected, got)
+			}
+			sum = sum.Add(payment)
+		}
+		if got, expected := residue.String(), tc.residue; got != expected {
+			t.Fatalf("invalid residue of %s, expected %s, got %s", tc.total, expected, got)
+		}
+		if sum.Cmp(tc.total) != 0 {
+			t.Fatalf("payments and residue sum %s is not equal to the total %s", sum, tc.total)
+		}
+	}
+}
+
+func Test_WaterfallResidue(t *testing.T) {
+	limit := Z.FromInt64(40)
+	payments, residue, err := Waterfall(Centi.MustParse("100.01"),
+		Tranche{Name: "a", Min: Milli.MustParse("10.005"), Cap: &limit},
+		Tranche{Name: "b", Priority: 1, Cap: &limit},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payments[0].String() != "40" || payments[1].String() != "40" {
+		t.Fatalf("invalid payments, got %s and %s", payments[0], payments[1])
+	}
+	if got, expected := residue.String(), "20.01"; got != expected {
+		t.Fatalf("invalid residue, expected %s, got %s", expected, got)
+	}
+}
+
+func Test_WaterfallErrors(t *testing.T) {
+	limit := Z.FromInt64(1)
+	if _, _, err := Waterfall(Centi.One().Neg()); !errors.Is(err, ErrNegativeTotal) {
+		t.Fatalf("expected ErrNegativeTotal, got %v", err)
+	}
+	if _, _, err := Waterfall(Centi.One(), Tranche{Min: Z.FromInt64(2), Cap: &limit}); !errors.Is(err, ErrInvalidTranche) {
+		t.Fatalf("expected ErrInvalidTranche, got %v", err)
+	}
+}